@@ -2,11 +2,16 @@ package mhooks
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
 )
 
+// ErrUnknownEventType is returned by ParseEvent for events of a type this package doesn't know, such as one Moov added
+// after this version was released.
+var ErrUnknownEventType = errors.New("invalid event type")
+
 // ParseEvent returns a webhook event with the hydrated payload.
 //
 // Access the event payload by calling the corresponding getter method.
@@ -37,6 +42,8 @@ func ParseEvent(r *http.Request, secret string) (*Event, error) {
 		eventData = &event.accountDeleted
 	case EventTypeAccountUpdated:
 		eventData = &event.accountUpdated
+	case EventTypeAccountVerificationUpdated:
+		eventData = &event.accountVerificationUpdated
 	case EventTypeBalanceUpdated:
 		eventData = &event.balanceUpdated
 	case EventTypeBankAccountCreated:
@@ -53,6 +60,8 @@ func ParseEvent(r *http.Request, secret string) (*Event, error) {
 		eventData = &event.cardAutoUpdated
 	case EventTypeCapabilityRequested:
 		eventData = &event.capabilityRequested
+	case EventTypeCapabilityRequirementUpdated:
+		eventData = &event.capabilityRequirementUpdated
 	case EventTypeCapabilityUpdated:
 		eventData = &event.capabilityUpdated
 	case EventTypeDisputeCreated:
@@ -88,7 +97,7 @@ func ParseEvent(r *http.Request, secret string) (*Event, error) {
 	case EventTypeWalletTransactionUpdated:
 		eventData = &event.walletTransactionUpdated
 	default:
-		return nil, fmt.Errorf("invalid event type: %v", event.EventType)
+		return nil, fmt.Errorf("%w: %v", ErrUnknownEventType, event.EventType)
 	}
 
 	err = json.Unmarshal(event.Data, eventData)
@@ -105,34 +114,36 @@ type Event struct {
 	CreatedOn time.Time       `json:"createdOn"`
 	Data      json.RawMessage `json:"data"`
 
-	accountCreated           *AccountCreated
-	accountDeleted           *AccountDeleted
-	accountUpdated           *AccountUpdated
-	balanceUpdated           *BalanceUpdated
-	bankAccountCreated       *BankAccountCreated
-	bankAccountDeleted       *BankAccountDeleted
-	bankAccountUpdated       *BankAccountUpdated
-	cancellationCreated      *CancellationCreated
-	cancellationUpdated      *CancellationUpdated
-	cardAutoUpdated          *CardAutoUpdated
-	capabilityRequested      *CapabilityRequested
-	capabilityUpdated        *CapabilityUpdated
-	disputeCreated           *DisputeCreated
-	disputeUpdated           *DisputeUpdated
-	networkIDUpdated         *NetworkIDUpdated
-	paymentMethodDisabled    *PaymentMethodDisabled
-	paymentMethodEnabled     *PaymentMethodEnabled
-	refundCreated            *RefundCreated
-	refundUpdated            *RefundUpdated
-	representativeCreated    *RepresentativeCreated
-	representativeDeleted    *RepresentativeDeleted
-	representativeUpdated    *RepresentativeUpdated
-	sweepCreated             *SweepCreated
-	sweepUpdated             *SweepUpdated
-	testPing                 *TestPing
-	transferCreated          *TransferCreated
-	transferUpdated          *TransferUpdated
-	walletTransactionUpdated *WalletTransactionUpdated
+	accountCreated               *AccountCreated
+	accountDeleted               *AccountDeleted
+	accountUpdated               *AccountUpdated
+	accountVerificationUpdated   *AccountVerificationUpdated
+	balanceUpdated               *BalanceUpdated
+	bankAccountCreated           *BankAccountCreated
+	bankAccountDeleted           *BankAccountDeleted
+	bankAccountUpdated           *BankAccountUpdated
+	cancellationCreated          *CancellationCreated
+	cancellationUpdated          *CancellationUpdated
+	cardAutoUpdated              *CardAutoUpdated
+	capabilityRequested          *CapabilityRequested
+	capabilityRequirementUpdated *CapabilityRequirementUpdated
+	capabilityUpdated            *CapabilityUpdated
+	disputeCreated               *DisputeCreated
+	disputeUpdated               *DisputeUpdated
+	networkIDUpdated             *NetworkIDUpdated
+	paymentMethodDisabled        *PaymentMethodDisabled
+	paymentMethodEnabled         *PaymentMethodEnabled
+	refundCreated                *RefundCreated
+	refundUpdated                *RefundUpdated
+	representativeCreated        *RepresentativeCreated
+	representativeDeleted        *RepresentativeDeleted
+	representativeUpdated        *RepresentativeUpdated
+	sweepCreated                 *SweepCreated
+	sweepUpdated                 *SweepUpdated
+	testPing                     *TestPing
+	transferCreated              *TransferCreated
+	transferUpdated              *TransferUpdated
+	walletTransactionUpdated     *WalletTransactionUpdated
 }
 
 func (e Event) AccountCreated() (*AccountCreated, error) {
@@ -159,6 +170,14 @@ func (e Event) AccountUpdated() (*AccountUpdated, error) {
 	return e.accountUpdated, nil
 }
 
+func (e Event) AccountVerificationUpdated() (*AccountVerificationUpdated, error) {
+	if e.EventType != EventTypeAccountVerificationUpdated {
+		return nil, newInvalidEventTypeError(EventTypeAccountVerificationUpdated, e.EventType)
+	}
+
+	return e.accountVerificationUpdated, nil
+}
+
 func (e Event) BalanceUpdated() (*BalanceUpdated, error) {
 	if e.EventType != EventTypeBalanceUpdated {
 		return nil, newInvalidEventTypeError(EventTypeBalanceUpdated, e.EventType)
@@ -223,6 +242,14 @@ func (e Event) CapabilityRequested() (*CapabilityRequested, error) {
 	return e.capabilityRequested, nil
 }
 
+func (e Event) CapabilityRequirementUpdated() (*CapabilityRequirementUpdated, error) {
+	if e.EventType != EventTypeCapabilityRequirementUpdated {
+		return nil, newInvalidEventTypeError(EventTypeCapabilityRequirementUpdated, e.EventType)
+	}
+
+	return e.capabilityRequirementUpdated, nil
+}
+
 func (e Event) CapabilityUpdated() (*CapabilityUpdated, error) {
 	if e.EventType != EventTypeCapabilityUpdated {
 		return nil, newInvalidEventTypeError(EventTypeCapabilityUpdated, e.EventType)
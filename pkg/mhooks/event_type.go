@@ -9,34 +9,36 @@ import (
 type EventType string
 
 const (
-	EventTypeAccountCreated           EventType = "account.created"
-	EventTypeAccountDeleted           EventType = "account.deleted"
-	EventTypeAccountUpdated           EventType = "account.updated"
-	EventTypeBalanceUpdated           EventType = "balance.updated"
-	EventTypeBankAccountCreated       EventType = "bankAccount.created"
-	EventTypeBankAccountDeleted       EventType = "bankAccount.deleted"
-	EventTypeBankAccountUpdated       EventType = "bankAccount.updated"
-	EventTypeCancellationCreated      EventType = "cancellation.created"
-	EventTypeCancellationUpdated      EventType = "cancellation.updated"
-	EventTypeCardAutoUpdated          EventType = "card.autoUpdated"
-	EventTypeCapabilityRequested      EventType = "capability.requested"
-	EventTypeCapabilityUpdated        EventType = "capability.updated"
-	EventTypeDisputeCreated           EventType = "dispute.created"
-	EventTypeDisputeUpdated           EventType = "dispute.updated"
-	EventTypeNetworkIDUpdated         EventType = "networkID.updated"
-	EventTypePaymentMethodDisabled    EventType = "paymentMethod.disabled"
-	EventTypePaymentMethodEnabled     EventType = "paymentMethod.enabled"
-	EventTypeRefundCreated            EventType = "refund.created"
-	EventTypeRefundUpdated            EventType = "refund.updated"
-	EventTypeRepresentativeCreated    EventType = "representative.created"
-	EventTypeRepresentativeDeleted    EventType = "representative.deleted"
-	EventTypeRepresentativeUpdated    EventType = "representative.updated"
-	EventTypeSweepCreated             EventType = "sweep.created"
-	EventTypeSweepUpdated             EventType = "sweep.updated"
-	EventTypeTestPing                 EventType = "event.test"
-	EventTypeTransferCreated          EventType = "transfer.created"
-	EventTypeTransferUpdated          EventType = "transfer.updated"
-	EventTypeWalletTransactionUpdated EventType = "walletTransaction.updated"
+	EventTypeAccountCreated               EventType = "account.created"
+	EventTypeAccountDeleted               EventType = "account.deleted"
+	EventTypeAccountUpdated               EventType = "account.updated"
+	EventTypeAccountVerificationUpdated   EventType = "account.verificationUpdated"
+	EventTypeBalanceUpdated               EventType = "balance.updated"
+	EventTypeBankAccountCreated           EventType = "bankAccount.created"
+	EventTypeBankAccountDeleted           EventType = "bankAccount.deleted"
+	EventTypeBankAccountUpdated           EventType = "bankAccount.updated"
+	EventTypeCancellationCreated          EventType = "cancellation.created"
+	EventTypeCancellationUpdated          EventType = "cancellation.updated"
+	EventTypeCardAutoUpdated              EventType = "card.autoUpdated"
+	EventTypeCapabilityRequested          EventType = "capability.requested"
+	EventTypeCapabilityRequirementUpdated EventType = "capability.requirementUpdated"
+	EventTypeCapabilityUpdated            EventType = "capability.updated"
+	EventTypeDisputeCreated               EventType = "dispute.created"
+	EventTypeDisputeUpdated               EventType = "dispute.updated"
+	EventTypeNetworkIDUpdated             EventType = "networkID.updated"
+	EventTypePaymentMethodDisabled        EventType = "paymentMethod.disabled"
+	EventTypePaymentMethodEnabled         EventType = "paymentMethod.enabled"
+	EventTypeRefundCreated                EventType = "refund.created"
+	EventTypeRefundUpdated                EventType = "refund.updated"
+	EventTypeRepresentativeCreated        EventType = "representative.created"
+	EventTypeRepresentativeDeleted        EventType = "representative.deleted"
+	EventTypeRepresentativeUpdated        EventType = "representative.updated"
+	EventTypeSweepCreated                 EventType = "sweep.created"
+	EventTypeSweepUpdated                 EventType = "sweep.updated"
+	EventTypeTestPing                     EventType = "event.test"
+	EventTypeTransferCreated              EventType = "transfer.created"
+	EventTypeTransferUpdated              EventType = "transfer.updated"
+	EventTypeWalletTransactionUpdated     EventType = "walletTransaction.updated"
 )
 
 type AccountCreated struct {
@@ -57,6 +59,14 @@ type AccountUpdated struct {
 	ForeignID string `json:"foreignID,omitempty"`
 }
 
+type AccountVerificationUpdated struct {
+	// ID of the account
+	AccountID string `json:"accountID"`
+	ForeignID string `json:"foreignID,omitempty"`
+	// Status of the account's identity verification
+	VerificationStatus moov.AccountVerificationStatus `json:"verificationStatus"`
+}
+
 type BalanceUpdated struct {
 	// ID of the Account associated with the wallet
 	AccountID string `json:"accountID"`
@@ -125,6 +135,17 @@ type CapabilityUpdated struct {
 	Status moov.CapabilityStatus `json:"status"`
 }
 
+type CapabilityRequirementUpdated struct {
+	Capability moov.CapabilityName `json:"capabilityID"`
+	// ID of the account requesting the capability
+	AccountID string `json:"accountID"`
+	ForeignID string `json:"foreignID,omitempty"`
+	// Status of the capability
+	Status moov.CapabilityStatus `json:"status"`
+	// Requirements still needed before the capability can be enabled
+	Requirements moov.Requirement `json:"requirements"`
+}
+
 type DisputeCreated struct {
 	// ID of the merchant's Account associated with the disputed transaction.
 	AccountID string `json:"accountID"`
@@ -0,0 +1,106 @@
+package mhooks
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// TypedEvent is a webhook event with its payload already unmarshalled into Data.
+type TypedEvent[T any] struct {
+	EventID   string
+	EventType EventType
+	CreatedOn time.Time
+	Data      T
+}
+
+type (
+	AccountCreatedEvent               = TypedEvent[AccountCreated]
+	AccountVerificationUpdatedEvent   = TypedEvent[AccountVerificationUpdated]
+	CapabilityRequirementUpdatedEvent = TypedEvent[CapabilityRequirementUpdated]
+	CapabilityUpdatedEvent            = TypedEvent[CapabilityUpdated]
+)
+
+// Handler is an http.Handler for the webhook URL. It verifies and parses each event then calls the functions
+// registered for its type. Events without a registered function, including those of types this package doesn't know,
+// are acknowledged and dropped so Moov doesn't retry them. Bodies that can't be parsed are rejected with a 400.
+//
+// Functions should be registered before the handler starts serving requests.
+type Handler struct {
+	secret string
+	funcs  map[EventType][]func(Event) error
+}
+
+// NewHandler returns a Handler verifying webhook signatures with the signing secret.
+func NewHandler(secret string) *Handler {
+	return &Handler{
+		secret: secret,
+		funcs:  map[EventType][]func(Event) error{},
+	}
+}
+
+// OnAccountCreated registers fn to be called for each account.created event.
+func (h *Handler) OnAccountCreated(fn func(AccountCreatedEvent)) {
+	on(h, EventTypeAccountCreated, Event.AccountCreated, fn)
+}
+
+// OnAccountVerificationUpdated registers fn to be called for each account.verificationUpdated event.
+func (h *Handler) OnAccountVerificationUpdated(fn func(AccountVerificationUpdatedEvent)) {
+	on(h, EventTypeAccountVerificationUpdated, Event.AccountVerificationUpdated, fn)
+}
+
+// OnCapabilityRequirementUpdated registers fn to be called for each capability.requirementUpdated event.
+func (h *Handler) OnCapabilityRequirementUpdated(fn func(CapabilityRequirementUpdatedEvent)) {
+	on(h, EventTypeCapabilityRequirementUpdated, Event.CapabilityRequirementUpdated, fn)
+}
+
+// OnCapabilityUpdated registers fn to be called for each capability.updated event.
+func (h *Handler) OnCapabilityUpdated(fn func(CapabilityUpdatedEvent)) {
+	on(h, EventTypeCapabilityUpdated, Event.CapabilityUpdated, fn)
+}
+
+func on[T any](h *Handler, eventType EventType, data func(Event) (*T, error), fn func(TypedEvent[T])) {
+	h.funcs[eventType] = append(h.funcs[eventType], func(e Event) error {
+		d, err := data(e)
+		if err != nil {
+			return err
+		}
+		if d == nil {
+			return fmt.Errorf("%v event %v has no data", e.EventType, e.EventID)
+		}
+
+		fn(TypedEvent[T]{
+			EventID:   e.EventID,
+			EventType: e.EventType,
+			CreatedOn: e.CreatedOn,
+			Data:      *d,
+		})
+		return nil
+	})
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	event, err := ParseEvent(r, h.secret)
+	if errors.Is(err, ErrInvalidSignature) {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	if errors.Is(err, ErrUnknownEventType) {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	for _, fn := range h.funcs[event.EventType] {
+		if err := fn(*event); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
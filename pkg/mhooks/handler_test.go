@@ -0,0 +1,140 @@
+package mhooks
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/moovfinancial/moov-go/pkg/moov"
+)
+
+func TestHandler(t *testing.T) {
+	const (
+		timestamp = "2024-04-26T21:20:55Z"
+		secret    = "my-webhook-signing-secret"
+		signature = "6231d03752de6963087e6aea1c78a27a0617b6df1c071195f30ed85defe34e02fd0bf3995949fe12dafd747c42de9cfae03b8aafcf69cceba5495f4c7b719d82"
+	)
+
+	send := func(t *testing.T, h http.Handler, eventType EventType, data string, signature string) (string, *httptest.ResponseRecorder) {
+		t.Helper()
+
+		eventID := uuid.NewString()
+		var body bytes.Buffer
+		err := json.NewEncoder(&body).Encode(Event{
+			EventID:   eventID,
+			EventType: eventType,
+			CreatedOn: time.Date(2024, time.April, 26, 21, 20, 55, 0, time.UTC),
+			Data:      json.RawMessage(data),
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/my-awesome-webhook-url", &body)
+		req.Header.Set("x-timestamp", timestamp)
+		req.Header.Set("x-nonce", "LwxF1Uk7QOeDq2nzB3theslHbtAo7y3uuncB1PoijwCZZaRZsd8DOtffBT7p")
+		req.Header.Set("x-webhook-id", "dff0a709-f982-4475-81e8-214b435c74ab")
+		req.Header.Set("x-signature", signature)
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return eventID, rec
+	}
+
+	t.Run("account.created", func(t *testing.T) {
+		var got []AccountCreatedEvent
+		h := NewHandler(secret)
+		h.OnAccountCreated(func(e AccountCreatedEvent) { got = append(got, e) })
+
+		eventID, rec := send(t, h, EventTypeAccountCreated, `{"accountID": "account-id", "foreignID": "foreign-id"}`, signature)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, []AccountCreatedEvent{{
+			EventID:   eventID,
+			EventType: EventTypeAccountCreated,
+			CreatedOn: time.Date(2024, time.April, 26, 21, 20, 55, 0, time.UTC),
+			Data:      AccountCreated{AccountID: "account-id", ForeignID: "foreign-id"},
+		}}, got)
+	})
+
+	t.Run("account.verificationUpdated", func(t *testing.T) {
+		var got []AccountVerificationUpdatedEvent
+		h := NewHandler(secret)
+		h.OnAccountVerificationUpdated(func(e AccountVerificationUpdatedEvent) { got = append(got, e) })
+
+		_, rec := send(t, h, EventTypeAccountVerificationUpdated, `{"accountID": "account-id", "verificationStatus": "verified"}`, signature)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Len(t, got, 1)
+		require.Equal(t, AccountVerificationUpdated{
+			AccountID:          "account-id",
+			VerificationStatus: moov.AccountVerificationStatus_Verified,
+		}, got[0].Data)
+	})
+
+	t.Run("capability.requirementUpdated", func(t *testing.T) {
+		var got []CapabilityRequirementUpdatedEvent
+		h := NewHandler(secret)
+		h.OnCapabilityRequirementUpdated(func(e CapabilityRequirementUpdatedEvent) { got = append(got, e) })
+
+		_, rec := send(t, h, EventTypeCapabilityRequirementUpdated, `{
+			"capabilityID": "transfers",
+			"accountID": "account-id",
+			"status": "pending",
+			"requirements": {"currentlyDue": ["individual.ssn"]}
+		}`, signature)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Len(t, got, 1)
+		require.Equal(t, moov.CapabilityName("transfers"), got[0].Data.Capability)
+		require.Equal(t, moov.CapabilityStatus_Pending, got[0].Data.Status)
+		require.Equal(t, []moov.RequirementId{"individual.ssn"}, got[0].Data.Requirements.CurrentlyDue)
+	})
+
+	t.Run("capability.updated", func(t *testing.T) {
+		var got []CapabilityUpdatedEvent
+		h := NewHandler(secret)
+		h.OnCapabilityUpdated(func(e CapabilityUpdatedEvent) { got = append(got, e) })
+
+		_, rec := send(t, h, EventTypeCapabilityUpdated, `{"capabilityID": "transfers", "accountID": "account-id", "status": "enabled"}`, signature)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Len(t, got, 1)
+		require.Equal(t, CapabilityUpdated{
+			Capability: "transfers",
+			AccountID:  "account-id",
+			Status:     moov.CapabilityStatus_Enabled,
+		}, got[0].Data)
+
+		// Events without a registered function are acknowledged
+		_, rec = send(t, h, EventTypeTestPing, `{"ping": true}`, signature)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Len(t, got, 1)
+	})
+
+	t.Run("unknown event type", func(t *testing.T) {
+		h := NewHandler(secret)
+		h.OnCapabilityUpdated(func(CapabilityUpdatedEvent) { t.Error("unexpected call") })
+
+		_, rec := send(t, h, EventType("something.new"), `{"id": "id"}`, signature)
+		require.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		h := NewHandler(secret)
+		h.OnCapabilityUpdated(func(CapabilityUpdatedEvent) { t.Error("unexpected call") })
+
+		_, rec := send(t, h, EventTypeCapabilityUpdated, `{"capabilityID": 1}`, signature)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		called := false
+		h := NewHandler(secret)
+		h.OnCapabilityUpdated(func(CapabilityUpdatedEvent) { called = true })
+
+		_, rec := send(t, h, EventTypeCapabilityUpdated, `{"capabilityID": "transfers"}`, "not-the-signature")
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+		require.False(t, called)
+	})
+}
@@ -10,3 +10,13 @@ Utility functions to help alleviate some annoyances with the API and Go in gener
 func PtrOf[A interface{}](c A) *A {
 	return &c
 }
+
+// applyOptions applies each functional option in turn to o, which should already hold the defaults. Used by the
+// optional settings of calls like WithDryRun and CreateAccount, each having its own Option type so options can't be
+// passed to the wrong call.
+func applyOptions[T interface{}, O ~func(*T)](o *T, opts []O) *T {
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
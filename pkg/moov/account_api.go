@@ -2,12 +2,49 @@ package moov
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 )
 
-// CreateAccount creates a new account.
-func (c Client) CreateAccount(ctx context.Context, account CreateAccount) (*Account, *Account, error) {
+// AccountOption changes the checks CreateAccount and PatchAccount make before sending the account to Moov.
+type AccountOption func(o *accountOptions)
+type accountOptions struct {
+	allowDuplicateForeignID bool
+	profileValidation       []ProfileValidationOption
+}
+
+// WithProfileValidation sets the options the account's profile is validated with, such as WithDefaultPhoneCountryCode.
+func WithProfileValidation(opts ...ProfileValidationOption) AccountOption {
+	return func(o *accountOptions) {
+		o.profileValidation = append(o.profileValidation, opts...)
+	}
+}
+
+// AllowDuplicateForeignID skips checking that no other account already has the account's foreignID, saving the call
+// listing accounts by it. Moov itself allows more than one account per foreignID.
+func AllowDuplicateForeignID() AccountOption {
+	return func(o *accountOptions) {
+		o.allowDuplicateForeignID = true
+	}
+}
+
+// CreateAccount creates a new account. The profile is validated and normalized first, see CreateProfile.Validate, and a
+// ForeignIDExistsError is returned if another account already has the foreignID, see AllowDuplicateForeignID.
+func (c Client) CreateAccount(ctx context.Context, account CreateAccount, opts ...AccountOption) (*Account, *Account, error) {
+	o := applyOptions(&accountOptions{}, opts)
+
+	if err := account.Profile.Validate(o.profileValidation...); err != nil {
+		return nil, nil, err
+	}
+	if err := account.AccountSettings.validate(); err != nil {
+		return nil, nil, err
+	}
+	if err := c.checkForeignID(ctx, "", account.ForeignID, o); err != nil {
+		return nil, nil, err
+	}
+
 	resp, err := c.CallHttp(ctx,
 		Endpoint(http.MethodPost, pathAccounts),
 		AcceptJson(),
@@ -55,8 +92,21 @@ func (c Client) UpdateAccount(ctx context.Context, account Account) (*Account, e
 	return CompletedObjectOrError[Account](resp)
 }
 
-// PatchAccount updates an account.
-func (c Client) PatchAccount(ctx context.Context, accountID string, account PatchAccount) (*Account, error) {
+// PatchAccount updates an account. The profile is validated and normalized first, see PatchProfile.Validate, and a
+// ForeignIDExistsError is returned if another account already has the foreignID, see AllowDuplicateForeignID.
+func (c Client) PatchAccount(ctx context.Context, accountID string, account PatchAccount, opts ...AccountOption) (*Account, error) {
+	o := applyOptions(&accountOptions{}, opts)
+
+	if err := account.Profile.Validate(o.profileValidation...); err != nil {
+		return nil, err
+	}
+	if err := account.AccountSettings.validate(); err != nil {
+		return nil, err
+	}
+	if err := c.checkForeignID(ctx, accountID, account.ForeignID, o); err != nil {
+		return nil, err
+	}
+
 	resp, err := c.CallHttp(ctx,
 		Endpoint(http.MethodPatch, pathAccount, accountID),
 		AcceptJson(),
@@ -68,6 +118,49 @@ func (c Client) PatchAccount(ctx context.Context, accountID string, account Patc
 	return CompletedObjectOrError[Account](resp)
 }
 
+// GetAccountSettings returns the account's card and ACH payment settings, which Moov uses as the default statement
+// descriptor and company name on its transfers. Moov returns settings as part of the account.
+func (c Client) GetAccountSettings(ctx context.Context, accountID string) (*AccountSettings, error) {
+	account, err := c.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if account.Settings == nil {
+		return &AccountSettings{}, nil
+	}
+	return account.Settings, nil
+}
+
+// UpdateAccountSettings patches the account's card and ACH payment settings, leaving the rest of the account as is.
+// ErrStatementDescriptorTooLong is returned without calling Moov if the statement descriptor or company name is longer
+// than its rail allows.
+func (c Client) UpdateAccountSettings(ctx context.Context, accountID string, settings AccountSettings) (*AccountSettings, error) {
+	if err := settings.validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.CallHttp(ctx,
+		Endpoint(http.MethodPatch, pathAccount, accountID),
+		AcceptJson(),
+		JsonBody(struct {
+			Settings AccountSettings `json:"settings"`
+		}{settings}))
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := CompletedObjectOrError[Account](resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if account.Settings == nil {
+		return &AccountSettings{}, nil
+	}
+	return account.Settings, nil
+}
+
 // Func that applies a filter and returns an error if validation fails
 type ListAccountFilter callArg
 
@@ -147,13 +240,77 @@ func (c Client) ListAccounts(ctx context.Context, opts ...ListAccountFilter) ([]
 	return CompletedListOrError[Account](resp)
 }
 
-func (c Client) DisconnectAccount(ctx context.Context, accountID string) error {
+// ListConnectedAccounts lists the accounts connected to the partner account, such as a platform's merchants. The call is
+// made on behalf of the partner with WithActingAccount to scope the listing to it when the client's credentials can act
+// for several partners. Moov doesn't document that header, so without it being honored the listing covers every
+// account the credentials can see. The partner's account ID must be a UUID.
+func (c Client) ListConnectedAccounts(ctx context.Context, partnerAccountID string, filters ...ListAccountFilter) ([]Account, error) {
+	return c.ListAccounts(ctx, append([]ListAccountFilter{WithActingAccount(partnerAccountID)}, filters...)...)
+}
+
+// GetConnectedAccount gets an account connected to the partner account, made on behalf of the partner like
+// ListConnectedAccounts.
+func (c Client) GetConnectedAccount(ctx context.Context, partnerAccountID, accountID string) (*Account, error) {
 	resp, err := c.CallHttp(ctx,
-		Endpoint(http.MethodDelete, pathAccount, accountID),
-		AcceptJson())
+		Endpoint(http.MethodGet, pathAccount, accountID),
+		AcceptJson(),
+		WithActingAccount(partnerAccountID))
+	if err != nil {
+		return nil, err
+	}
+
+	return CompletedObjectOrError[Account](resp)
+}
+
+// GetAccountByForeignID returns the single account with the given foreignID. ErrForeignIDNotFound is returned if there
+// isn't one and ErrMultipleAccountsFound if the foreignID isn't unique.
+func (c Client) GetAccountByForeignID(ctx context.Context, foreignID string) (*Account, error) {
+	// Two is enough to tell if the match is ambiguous
+	accounts, err := c.ListAccounts(ctx, WithAccountForeignID(foreignID), WithAccountCount(2))
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	switch len(accounts) {
+	case 0:
+		return nil, fmt.Errorf("%w: %s", ErrForeignIDNotFound, foreignID)
+	case 1:
+		return &accounts[0], nil
+	default:
+		return nil, fmt.Errorf("%w: foreignID %s", ErrMultipleAccountsFound, foreignID)
 	}
+}
 
-	return CompletedNilOrError(resp)
+// checkForeignID returns a ForeignIDExistsError if an account other than accountID already has the foreignID, unless
+// AllowDuplicateForeignID is given. The check and the call that follows aren't atomic, so two accounts created at once
+// can still end up sharing a foreignID.
+func (c Client) checkForeignID(ctx context.Context, accountID, foreignID string, o *accountOptions) error {
+	if foreignID == "" || o.allowDuplicateForeignID {
+		return nil
+	}
+
+	// Two is enough to find another account when the one being patched already has the foreignID
+	accounts, err := c.ListAccounts(ctx, WithAccountForeignID(foreignID), WithAccountCount(2))
+	if err != nil {
+		return fmt.Errorf("checking foreignID %s is unique: %w", foreignID, err)
+	}
+
+	for _, existing := range accounts {
+		if existing.AccountID != accountID {
+			return &ForeignIDExistsError{ForeignID: foreignID, AccountID: existing.AccountID}
+		}
+	}
+	return nil
+}
+
+// DisconnectAccount closes the account so it can no longer be used to move money. Closing an account is irreversible, a
+// closed account can't be reopened and a new account has to be created in its place. If the account still has funds
+// in its wallet or transfers that haven't completed ErrAccountNotDisconnectable is returned.
+// https://docs.moov.io/api/moov-accounts/accounts/disconnect/
+func (c Client) DisconnectAccount(ctx context.Context, accountID string) error {
+	err := c.delete(ctx, Endpoint(http.MethodDelete, pathAccount, accountID))
+	if resp := ErrorAsCallResponse(err); resp != nil && resp.Status() == StatusStateConflict {
+		return errors.Join(ErrAccountNotDisconnectable, err)
+	}
+	return err
 }
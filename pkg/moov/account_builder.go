@@ -0,0 +1,170 @@
+package moov
+
+import (
+	"errors"
+	"maps"
+	"regexp"
+	"slices"
+)
+
+// AccountBuilder assembles a CreateAccount one field at a time, checking it has what Moov requires when it's built.
+// Start one with NewIndividualAccount or NewBusinessAccount.
+type AccountBuilder struct {
+	account CreateAccount
+	// Builder methods used on the wrong type of account
+	errs FieldErrors
+}
+
+// NewIndividualAccount starts building an account for a person.
+func NewIndividualAccount(firstName, lastName string) *AccountBuilder {
+	return &AccountBuilder{
+		account: CreateAccount{
+			Type: AccountType_Individual,
+			Profile: CreateProfile{
+				Individual: &CreateIndividualProfile{
+					Name: Name{FirstName: firstName, LastName: lastName},
+				},
+			},
+		},
+		errs: FieldErrors{},
+	}
+}
+
+// NewBusinessAccount starts building an account for a business with its legal name and employer identification number.
+func NewBusinessAccount(legalName, ein string) *AccountBuilder {
+	return &AccountBuilder{
+		account: CreateAccount{
+			Type: AccountType_Business,
+			Profile: CreateProfile{
+				Business: &CreateBusinessProfile{
+					Name:  legalName,
+					TaxID: &TaxID{EIN: EIN{Number: ein}},
+				},
+			},
+		},
+		errs: FieldErrors{},
+	}
+}
+
+func (b *AccountBuilder) WithEmail(email string) *AccountBuilder {
+	if b.account.Profile.Individual != nil {
+		b.account.Profile.Individual.Email = email
+	} else {
+		b.account.Profile.Business.Email = email
+	}
+	return b
+}
+
+func (b *AccountBuilder) WithPhone(phone Phone) *AccountBuilder {
+	if b.account.Profile.Individual != nil {
+		b.account.Profile.Individual.Phone = &phone
+	} else {
+		b.account.Profile.Business.Phone = &phone
+	}
+	return b
+}
+
+func (b *AccountBuilder) WithAddress(address Address) *AccountBuilder {
+	if b.account.Profile.Individual != nil {
+		b.account.Profile.Individual.Address = &address
+	} else {
+		b.account.Profile.Business.Address = &address
+	}
+	return b
+}
+
+// WithBirthDate sets an individual's date of birth.
+func (b *AccountBuilder) WithBirthDate(birthDate Date) *AccountBuilder {
+	if b.account.Profile.Individual == nil {
+		b.errs["profile.business.birthDate"] = "only individual accounts have a birth date"
+		return b
+	}
+	b.account.Profile.Individual.BirthDate = &birthDate
+	return b
+}
+
+// WithBusinessType sets a business's structure, such as BusinessType_Llc.
+func (b *AccountBuilder) WithBusinessType(businessType BusinessType) *AccountBuilder {
+	if b.account.Profile.Business == nil {
+		b.errs["profile.individual.businessType"] = "only business accounts have a business type"
+		return b
+	}
+	b.account.Profile.Business.Type = businessType
+	return b
+}
+
+func (b *AccountBuilder) WithForeignID(foreignID string) *AccountBuilder {
+	b.account.ForeignID = foreignID
+	return b
+}
+
+func (b *AccountBuilder) WithMetadata(key, value string) *AccountBuilder {
+	if b.account.Metadata == nil {
+		b.account.Metadata = map[string]string{}
+	}
+	b.account.Metadata[key] = value
+	return b
+}
+
+// WithTermsOfService records the account accepting Moov's terms of service with a token from Moov.js.
+func (b *AccountBuilder) WithTermsOfService(token string) *AccountBuilder {
+	b.account.TermsOfService = &TermsOfServicePayload{Token: token}
+	return b
+}
+
+// RequestCapabilities adds capabilities to request for the account when it's created.
+func (b *AccountBuilder) RequestCapabilities(capabilities ...CapabilityName) *AccountBuilder {
+	for _, capability := range capabilities {
+		if !slices.Contains(b.account.RequestedCapabilities, capability) {
+			b.account.RequestedCapabilities = append(b.account.RequestedCapabilities, capability)
+		}
+	}
+	return b
+}
+
+// Validate checks the account has the fields Moov requires and that they pass CreateProfile.Validate. A FieldErrors
+// is returned listing every missing or invalid field.
+func (b *AccountBuilder) Validate() error {
+	_, err := b.Build()
+	return err
+}
+
+var einNumber = regexp.MustCompile(`^\d{2}-?\d{7}$`)
+
+// Build returns the account to pass to CreateAccount, with its profile normalized the same as CreateProfile.Validate.
+// A FieldErrors is returned instead if it's missing or has invalid fields. The builder can still be changed and built
+// again afterwards.
+func (b *AccountBuilder) Build() (CreateAccount, error) {
+	account := b.account
+	account.Metadata = maps.Clone(b.account.Metadata)
+	account.RequestedCapabilities = slices.Clone(b.account.RequestedCapabilities)
+
+	errs := maps.Clone(b.errs)
+	required := func(field, value string) {
+		if value == "" {
+			errs[field] = "is required"
+		}
+	}
+
+	if individual := account.Profile.Individual; individual != nil {
+		required("profile.individual.name.firstName", individual.Name.FirstName)
+		required("profile.individual.name.lastName", individual.Name.LastName)
+		required("profile.individual.email", individual.Email)
+	}
+	if business := account.Profile.Business; business != nil {
+		required("profile.business.legalBusinessName", business.Name)
+		if ein := business.TaxID.EIN.Number; !einNumber.MatchString(ein) {
+			errs["profile.business.taxID.ein.number"] = "must be 9 digits, such as 12-3456789"
+		}
+	}
+
+	var invalid FieldErrors
+	if errors.As(account.Profile.Validate(), &invalid) {
+		maps.Copy(errs, invalid)
+	}
+
+	if len(errs) > 0 {
+		return CreateAccount{}, errs
+	}
+	return account, nil
+}
@@ -1,6 +1,7 @@
 package moov
 
 import (
+	"fmt"
 	"time"
 )
 
@@ -361,6 +362,25 @@ type AccountSettings struct {
 	AchPayment  *AchPaymentSettings  `json:"achPayment,omitempty"`
 }
 
+func (s *AccountSettings) validate() error {
+	if s == nil {
+		return nil
+	}
+
+	if s.CardPayment != nil {
+		if err := checkDescriptor(s.CardPayment.StatementDescriptor, MaxCardDynamicDescriptorLength); err != nil {
+			return fmt.Errorf("settings.cardPayment.statementDescriptor: %w", err)
+		}
+	}
+	if s.AchPayment != nil {
+		if err := checkDescriptor(s.AchPayment.CompanyName, MaxAchOriginatingCompanyNameLength); err != nil {
+			return fmt.Errorf("settings.achPayment.companyName: %w", err)
+		}
+	}
+
+	return nil
+}
+
 // CardPaymentSettings User provided settings to manage card payments. This data is only allowed on a business account.
 type CardPaymentSettings struct {
 	// The description that shows up on credit card transactions. This will default to the accounts display name on account creation.
@@ -4,7 +4,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/moovfinancial/moov-go/pkg/moov"
@@ -290,3 +293,324 @@ func TestDisconnectAccount(t *testing.T) {
 	err = mc.DisconnectAccount(ctx, accnt.AccountID)
 	NoResponseError(t, err)
 }
+
+func TestDisconnectAccount_NonzeroBalance(t *testing.T) {
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodDelete, r.Method)
+		require.Equal(t, "/accounts/account-id", r.URL.Path)
+
+		writeJson(t, w, http.StatusConflict, map[string]string{"error": "wallet balance must be zero"})
+	}))
+
+	err := mc.DisconnectAccount(context.Background(), "account-id")
+	require.ErrorIs(t, err, moov.ErrAccountNotDisconnectable)
+	require.Equal(t, moov.StatusStateConflict, moov.ErrorAsCallResponse(err).Status())
+}
+
+func TestCreateAccount_ForeignIDExists(t *testing.T) {
+	var created, patched, listed int
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/accounts":
+			listed++
+			var matches []moov.Account
+			if r.URL.Query().Get("foreignID") == "user-1" {
+				matches = append(matches, moov.Account{AccountID: "account-1", ForeignID: "user-1"})
+			}
+			writeJson(t, w, http.StatusOK, matches)
+		case r.Method == http.MethodPost && r.URL.Path == "/accounts":
+			created++
+			writeJson(t, w, http.StatusOK, moov.Account{AccountID: "account-2", ForeignID: "user-1"})
+		case r.Method == http.MethodPatch:
+			patched++
+			writeJson(t, w, http.StatusOK, moov.Account{AccountID: strings.TrimPrefix(r.URL.Path, "/accounts/"), ForeignID: "user-1"})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	account := moov.CreateAccount{
+		Type: moov.AccountType_Individual,
+		Profile: moov.CreateProfile{
+			Individual: &moov.CreateIndividualProfile{
+				Name: moov.Name{FirstName: "Jules", LastName: "Jackson"},
+			},
+		},
+		ForeignID: "user-1",
+	}
+
+	_, _, err := mc.CreateAccount(BgCtx(), account)
+	require.ErrorIs(t, err, moov.ErrForeignIDExists)
+
+	var exists *moov.ForeignIDExistsError
+	require.ErrorAs(t, err, &exists)
+	require.Equal(t, "account-1", exists.AccountID)
+	require.Equal(t, "user-1", exists.ForeignID)
+	require.Zero(t, created)
+
+	_, err = mc.PatchAccount(BgCtx(), "account-3", moov.PatchAccount{ForeignID: "user-1"})
+	require.ErrorIs(t, err, moov.ErrForeignIDExists)
+	require.Zero(t, patched)
+
+	// The account that already has the foreignID can keep it
+	_, err = mc.PatchAccount(BgCtx(), "account-1", moov.PatchAccount{ForeignID: "user-1"})
+	require.NoError(t, err)
+	require.Equal(t, 1, patched)
+
+	// Duplicates are created without listing accounts first when allowed
+	a, _, err := mc.CreateAccount(BgCtx(), account, moov.AllowDuplicateForeignID())
+	require.NoError(t, err)
+	require.Equal(t, "account-2", a.AccountID)
+	require.Equal(t, 1, created)
+	require.Equal(t, 3, listed)
+
+	account.ForeignID = "user-2"
+	_, _, err = mc.CreateAccount(BgCtx(), account)
+	require.NoError(t, err)
+	require.Equal(t, 2, created)
+}
+
+func TestGetAccountByForeignID(t *testing.T) {
+	matches := map[string][]moov.Account{
+		"user-0": {},
+		"user-1": {{AccountID: "account-1", ForeignID: "user-1"}},
+		"user-2": {{AccountID: "account-2a", ForeignID: "user-2"}, {AccountID: "account-2b", ForeignID: "user-2"}},
+	}
+
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/accounts", r.URL.Path)
+		require.Equal(t, "2", r.URL.Query().Get("count"))
+
+		writeJson(t, w, http.StatusOK, matches[r.URL.Query().Get("foreignID")])
+	}))
+
+	t.Run("none", func(t *testing.T) {
+		_, err := mc.GetAccountByForeignID(context.Background(), "user-0")
+		require.ErrorIs(t, err, moov.ErrForeignIDNotFound)
+		require.NotErrorIs(t, err, moov.ErrAccountNotFound)
+	})
+
+	t.Run("one", func(t *testing.T) {
+		account, err := mc.GetAccountByForeignID(context.Background(), "user-1")
+		require.NoError(t, err)
+		require.Equal(t, "account-1", account.AccountID)
+	})
+
+	t.Run("multiple", func(t *testing.T) {
+		_, err := mc.GetAccountByForeignID(context.Background(), "user-2")
+		require.ErrorIs(t, err, moov.ErrMultipleAccountsFound)
+	})
+}
+
+func TestListConnectedAccounts(t *testing.T) {
+	partnerAccountID := uuid.NewString()
+
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, partnerAccountID, r.Header.Get(moov.ActingAccountHeader))
+		publicKey, _, ok := r.BasicAuth()
+		require.True(t, ok)
+		require.Equal(t, "public-key", publicKey)
+
+		switch r.URL.Path {
+		case "/accounts":
+			require.Equal(t, "business", r.URL.Query().Get("type"))
+			writeJson(t, w, http.StatusOK, []moov.Account{{AccountID: "merchant-id"}})
+		case "/accounts/merchant-id":
+			writeJson(t, w, http.StatusOK, moov.Account{AccountID: "merchant-id"})
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	accounts, err := mc.ListConnectedAccounts(BgCtx(), partnerAccountID, moov.WithAccountType("business"))
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+
+	account, err := mc.GetConnectedAccount(BgCtx(), partnerAccountID, "merchant-id")
+	require.NoError(t, err)
+	require.Equal(t, "merchant-id", account.AccountID)
+
+	_, err = mc.ListConnectedAccounts(BgCtx(), "not-a-uuid")
+	require.ErrorContains(t, err, "must be a UUID")
+}
+
+func TestAccountSettings_RoundTrip(t *testing.T) {
+	account := moov.Account{AccountID: "account-id", DisplayName: "Whole Body Fitness"}
+
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/accounts/account-id", r.URL.Path)
+
+		switch r.Method {
+		case http.MethodGet:
+			writeJson(t, w, http.StatusOK, account)
+		case http.MethodPatch:
+			body := map[string]json.RawMessage{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			require.Len(t, body, 1, "only settings should be patched")
+			require.NoError(t, json.Unmarshal(body["settings"], &account.Settings))
+
+			writeJson(t, w, http.StatusOK, account)
+		default:
+			t.Errorf("unexpected %s request", r.Method)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	settings, err := mc.GetAccountSettings(context.Background(), "account-id")
+	require.NoError(t, err)
+	require.Equal(t, moov.AccountSettings{}, *settings)
+
+	want := moov.AccountSettings{
+		CardPayment: &moov.CardPaymentSettings{StatementDescriptor: "WHOLEBODY FITNESS"},
+		AchPayment:  &moov.AchPaymentSettings{CompanyName: "Whole Body"},
+	}
+	updated, err := mc.UpdateAccountSettings(context.Background(), "account-id", want)
+	require.NoError(t, err)
+	require.Equal(t, want, *updated)
+
+	settings, err = mc.GetAccountSettings(context.Background(), "account-id")
+	require.NoError(t, err)
+	require.Equal(t, want, *settings)
+
+	t.Run("descriptor too long", func(t *testing.T) {
+		_, err := mc.UpdateAccountSettings(context.Background(), "account-id", moov.AccountSettings{
+			CardPayment: &moov.CardPaymentSettings{StatementDescriptor: "WHOLEBODY FITNESS DOWNTOWN"},
+		})
+		require.ErrorIs(t, err, moov.ErrStatementDescriptorTooLong)
+		require.ErrorContains(t, err, "settings.cardPayment.statementDescriptor")
+
+		_, err = mc.UpdateAccountSettings(context.Background(), "account-id", moov.AccountSettings{
+			AchPayment: &moov.AchPaymentSettings{CompanyName: "Whole Body Fitness LLC"},
+		})
+		require.ErrorIs(t, err, moov.ErrStatementDescriptorTooLong)
+		require.ErrorContains(t, err, "settings.achPayment.companyName")
+	})
+}
+
+func TestCreateAccount_SettingsTooLong(t *testing.T) {
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	account := moov.CreateAccount{
+		Type: moov.AccountType_Business,
+		Profile: moov.CreateProfile{
+			Business: &moov.CreateBusinessProfile{Name: "Whole Body Fitness"},
+		},
+		AccountSettings: &moov.AccountSettings{
+			CardPayment: &moov.CardPaymentSettings{StatementDescriptor: "WHOLEBODY FITNESS DOWNTOWN"},
+		},
+	}
+
+	_, _, err := mc.CreateAccount(BgCtx(), account)
+	require.ErrorIs(t, err, moov.ErrStatementDescriptorTooLong)
+	require.ErrorContains(t, err, "settings.cardPayment.statementDescriptor")
+
+	account.AccountSettings = &moov.AccountSettings{
+		AchPayment: &moov.AchPaymentSettings{CompanyName: "Whole Body Fitness LLC"},
+	}
+	_, _, err = mc.CreateAccount(BgCtx(), account)
+	require.ErrorIs(t, err, moov.ErrStatementDescriptorTooLong)
+	require.ErrorContains(t, err, "settings.achPayment.companyName")
+}
+
+func TestAccount_Timestamps(t *testing.T) {
+	input := []byte(`{
+		"accountID": "ec7e1848-dc80-4ab0-8827-dd7fc0737b43",
+		"displayName": "Whole Body Fitness",
+		"createdOn": "2024-05-01T14:30:00Z",
+		"updatedOn": "2024-05-02T09:15:42.123456789Z"
+	}`)
+
+	account := new(moov.Account)
+
+	dec := json.NewDecoder(bytes.NewReader(input))
+	dec.DisallowUnknownFields()
+	require.NoError(t, dec.Decode(account))
+
+	require.Equal(t, time.Date(2024, time.May, 1, 14, 30, 0, 0, time.UTC), account.CreatedOn.UTC())
+	require.Equal(t, time.Date(2024, time.May, 2, 9, 15, 42, 123456789, time.UTC), account.UpdatedOn.UTC())
+	require.Equal(t, account.UpdatedOn, account.LastModified())
+
+	disconnectedOn := account.UpdatedOn.Add(time.Hour)
+	account.DisconnectedOn = &disconnectedOn
+	require.Equal(t, disconnectedOn, account.LastModified())
+
+	out, err := json.Marshal(account)
+	require.NoError(t, err)
+	require.Contains(t, string(out), `"createdOn":"2024-05-01T14:30:00Z"`)
+	require.NotContains(t, string(out), `disabledOn`)
+
+	// Resources are composite literals with their timestamps set directly
+	var resource moov.Timestamped = moov.Schedule{CreatedOn: account.CreatedOn}
+	require.Equal(t, account.CreatedOn, resource.LastModified())
+}
+
+func TestAccountBuilder(t *testing.T) {
+	address := moov.Address{
+		AddressLine1:    "123 Main St",
+		City:            "Moov City",
+		StateOrProvince: "co",
+		PostalCode:      "80301",
+		Country:         "US",
+	}
+
+	t.Run("individual", func(t *testing.T) {
+		account, err := moov.NewIndividualAccount("Jordan", "Lee").
+			WithEmail("jordan@moov.io").
+			WithPhone(moov.Phone{Number: "(555) 555-5555"}).
+			WithAddress(address).
+			WithBirthDate(moov.Date{Year: 1990, Month: 1, Day: 15}).
+			WithForeignID("user-1234").
+			RequestCapabilities(moov.CapabilityName_Transfers, moov.CapabilityName_SendFunds, moov.CapabilityName_Transfers).
+			Build()
+		require.NoError(t, err)
+
+		require.Equal(t, moov.AccountType_Individual, account.Type)
+		require.Equal(t, moov.Name{FirstName: "Jordan", LastName: "Lee"}, account.Profile.Individual.Name)
+		require.Equal(t, "jordan@moov.io", account.Profile.Individual.Email)
+		require.Equal(t, &moov.Phone{Number: "5555555555", CountryCode: "1"}, account.Profile.Individual.Phone)
+		require.Equal(t, "CO", account.Profile.Individual.Address.StateOrProvince)
+		require.Equal(t, "user-1234", account.ForeignID)
+		require.Equal(t, []moov.CapabilityName{moov.CapabilityName_Transfers, moov.CapabilityName_SendFunds}, account.RequestedCapabilities)
+		require.Nil(t, account.Profile.Business)
+	})
+
+	t.Run("business", func(t *testing.T) {
+		account, err := moov.NewBusinessAccount("Whole Body Fitness LLC", "12-3456789").
+			WithBusinessType(moov.BusinessType_Llc).
+			WithEmail("owner@wholebodyfitness.example").
+			WithAddress(address).
+			WithMetadata("plan", "premium").
+			Build()
+		require.NoError(t, err)
+
+		require.Equal(t, moov.AccountType_Business, account.Type)
+		require.Equal(t, "Whole Body Fitness LLC", account.Profile.Business.Name)
+		require.Equal(t, "12-3456789", account.Profile.Business.TaxID.EIN.Number)
+		require.Equal(t, moov.BusinessType_Llc, account.Profile.Business.Type)
+		require.Equal(t, map[string]string{"plan": "premium"}, account.Metadata)
+		require.Nil(t, account.Profile.Individual)
+	})
+
+	t.Run("missing and invalid fields", func(t *testing.T) {
+		builder := moov.NewIndividualAccount("Jordan", "").
+			WithPhone(moov.Phone{Number: "555-5555"}).
+			WithBusinessType(moov.BusinessType_Llc)
+
+		_, err := builder.Build()
+		var fieldErrs moov.FieldErrors
+		require.ErrorAs(t, err, &fieldErrs)
+		require.Contains(t, fieldErrs, "profile.individual.name.lastName")
+		require.Contains(t, fieldErrs, "profile.individual.email")
+		require.Contains(t, fieldErrs, "profile.individual.phone.number")
+		require.Contains(t, fieldErrs, "profile.individual.businessType")
+		require.Len(t, fieldErrs, 4)
+
+		require.Error(t, moov.NewBusinessAccount("", "123").Validate())
+		require.NoError(t, moov.NewBusinessAccount("Whole Body Fitness LLC", "123456789").Validate())
+	})
+}
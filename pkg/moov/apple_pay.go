@@ -169,3 +169,38 @@ func (c Client) LinkApplePayToken(ctx context.Context, accountID string, req Lin
 		return nil, resp
 	}
 }
+
+// ApplePayStatus is an account's Apple Pay setup, its registered domains along with the Apple Pay tokens linked to it.
+type ApplePayStatus struct {
+	// Nil if the account hasn't registered any domains.
+	Domains *ApplePayDomainsResponse
+	// The apple-pay payment methods of tokens linked to the account.
+	Tokens []PaymentMethod
+}
+
+// Ready reports if the account has domains registered, which Apple Pay requires before taking payments.
+func (s ApplePayStatus) Ready() bool {
+	return s.Domains != nil && len(s.Domains.Domains) > 0
+}
+
+// ApplePayStatus gets the account's registered Apple Pay domains and linked tokens. Linked tokens are listed as the
+// account's apple-pay payment methods.
+func (c Client) ApplePayStatus(ctx context.Context, accountID string) (*ApplePayStatus, error) {
+	domains, err := c.GetApplePayDomain(ctx, accountID)
+	if resp := ErrorAsCallResponse(err); resp != nil && resp.Status() == StatusNotFound {
+		domains, err = nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := c.ListPaymentMethods(ctx, accountID, WithPaymentMethodType(string(PaymentMethodType_ApplePay)))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApplePayStatus{
+		Domains: domains,
+		Tokens:  tokens,
+	}, nil
+}
@@ -3,6 +3,7 @@ package moov_test
 import (
 	"bytes"
 	"encoding/json"
+	"net/http"
 	"testing"
 
 	"github.com/moovfinancial/moov-go/pkg/moov"
@@ -36,6 +37,64 @@ func TestApplePayMarshal(t *testing.T) {
 	assert.Equal(t, "Visa 1234", applePay.CardDisplayName)
 }
 
+func TestApplePayStatus(t *testing.T) {
+	domainsFixture := `{
+		"accountID": "account-id",
+		"displayName": "Wholebody Fitness",
+		"domains": ["checkout.wholebody.example"],
+		"createdOn": "2024-01-01T00:00:00Z",
+		"updatedOn": "2024-01-01T00:00:00Z"
+	}`
+	tokensFixture := `[{
+		"paymentMethodID": "apple-pay-pm-id",
+		"paymentMethodType": "apple-pay",
+		"applePay": {
+			"brand": "Visa",
+			"cardType": "debit",
+			"cardDisplayName": "Visa 1256",
+			"fingerprint": "9948962d92a1ce40c9f918cd9ece3a22bde62fb325a2f1fe2e833969de672ba3",
+			"expiration": {"month": "01", "year": "29"},
+			"dynamicLastFour": "4321"
+		}
+	}]`
+
+	registered := true
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/accounts/account-id/apple-pay/domains":
+			if !registered {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(domainsFixture))
+		case "/accounts/account-id/payment-methods":
+			require.Equal(t, "apple-pay", r.URL.Query().Get("paymentMethodType"))
+			_, _ = w.Write([]byte(tokensFixture))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	status, err := mc.ApplePayStatus(BgCtx(), "account-id")
+	require.NoError(t, err)
+	require.True(t, status.Ready())
+	require.Equal(t, []string{"checkout.wholebody.example"}, status.Domains.Domains)
+	require.Len(t, status.Tokens, 1)
+	require.Equal(t, "4321", status.Tokens[0].ApplePay.DynamicLastFour)
+
+	t.Run("no domains registered", func(t *testing.T) {
+		registered = false
+
+		status, err := mc.ApplePayStatus(BgCtx(), "account-id")
+		require.NoError(t, err)
+		require.False(t, status.Ready())
+		require.Nil(t, status.Domains)
+	})
+}
+
 /*
 @TODO fix by getting rid of the suite
 
@@ -3,7 +3,10 @@ package moov
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 )
 
 type CreateBankAccountType callArg
@@ -57,6 +60,34 @@ func (c Client) CreateBankAccount(ctx context.Context, accountID string, opts ..
 	}
 }
 
+// LinkBankAccountWithPlaid exchanges a Plaid Link public token for an instantly verified bank account, skipping
+// micro-deposits. If Plaid rejects the token ErrPlaidLinkFailed is returned joined with Moov's response.
+// https://docs.moov.io/guides/sources/bank-accounts/plaid/
+func (c Client) LinkBankAccountWithPlaid(ctx context.Context, accountID string, req PlaidLinkRequest) (*BankAccount, error) {
+	if strings.TrimSpace(req.PublicToken) == "" {
+		return nil, ErrPlaidTokenRequired
+	}
+
+	resp, err := c.CallHttp(ctx,
+		Endpoint(http.MethodPost, pathBankAccounts, accountID),
+		AcceptJson(),
+		WithPlaidLink(req))
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.Status() {
+	case StatusCompleted:
+		return CompletedObjectOrError[BankAccount](resp)
+	case StatusStateConflict:
+		return nil, errors.Join(ErrAlreadyExists, resp)
+	case StatusBadRequest, StatusFailedValidation:
+		return nil, errors.Join(ErrPlaidLinkFailed, resp)
+	default:
+		return nil, resp
+	}
+}
+
 // GetBankAccount retrieves a bank account for the given customer account
 // https://docs.moov.io/api/sources/bank-accounts/get/
 func (c Client) GetBankAccount(ctx context.Context, accountID string, bankAccountID string) (*BankAccount, error) {
@@ -73,17 +104,23 @@ func (c Client) GetBankAccount(ctx context.Context, accountID string, bankAccoun
 // DeleteBankAccount deletes a bank account for the given customer account
 // https://docs.moov.io/api/sources/bank-accounts/delete/
 func (c Client) DeleteBankAccount(ctx context.Context, accountID string, bankAccountID string) error {
-	resp, err := c.CallHttp(ctx, Endpoint(http.MethodDelete, pathBankAccount, accountID, bankAccountID))
-	if err != nil {
-		return err
-	}
+	return c.delete(ctx, Endpoint(http.MethodDelete, pathBankAccount, accountID, bankAccountID))
+}
 
-	return CompletedNilOrError(resp)
+// BankAccountFilter narrows the bank accounts returned by ListBankAccounts. Moov's list endpoint doesn't support
+// filtering, so filters are applied client-side to the full list.
+type BankAccountFilter func(bankAccount BankAccount) bool
+
+// WithBankAccountStatus keeps bank accounts in any of the given statuses, such as BankAccountStatus_Verified
+func WithBankAccountStatus(statuses ...BankAccountStatus) BankAccountFilter {
+	return func(bankAccount BankAccount) bool {
+		return slices.Contains(statuses, bankAccount.Status)
+	}
 }
 
-// ListBankAccounts lists all bank accounts for the given customer account
+// ListBankAccounts lists all bank accounts for the given customer account, keeping those matching every filter
 // https://docs.moov.io/api/sources/bank-accounts/list/
-func (c Client) ListBankAccounts(ctx context.Context, accountID string) ([]BankAccount, error) {
+func (c Client) ListBankAccounts(ctx context.Context, accountID string, filters ...BankAccountFilter) ([]BankAccount, error) {
 	resp, err := c.CallHttp(ctx,
 		Endpoint(http.MethodGet, pathBankAccounts, accountID),
 		AcceptJson())
@@ -91,12 +128,60 @@ func (c Client) ListBankAccounts(ctx context.Context, accountID string) ([]BankA
 		return nil, err
 	}
 
-	return CompletedListOrError[BankAccount](resp)
+	bankAccounts, err := CompletedListOrError[BankAccount](resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterList(bankAccounts, filters), nil
+}
+
+// FindBankAccount returns the account's bank account with the given routing number and last four digits of its account
+// number, for when only the masked details are known. The first match is returned if several bank accounts match.
+func (c Client) FindBankAccount(ctx context.Context, accountID, routingNumber, lastFour string) (*BankAccount, error) {
+	bankAccounts, err := c.ListBankAccounts(ctx, accountID, func(bankAccount BankAccount) bool {
+		return bankAccount.RoutingNumber == routingNumber && bankAccount.LastFourAccountNumber == lastFour
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bankAccounts) == 0 {
+		return nil, fmt.Errorf("%w: routing number %s ending in %s", ErrBankAccountNotFound, routingNumber, lastFour)
+	}
+	return &bankAccounts[0], nil
+}
+
+// MicroDepositInitiateOption changes the checks MicroDepositInitiate makes before sending micro-deposits.
+type MicroDepositInitiateOption func(o *microDepositInitiate)
+type microDepositInitiate struct {
+	force bool
 }
 
-// MicroDepositInitiate creates a new micro deposit verification for the given bank account
+// WithForceMicroDeposits sends micro-deposits without first checking if some are already pending verification.
+func WithForceMicroDeposits() MicroDepositInitiateOption {
+	return func(o *microDepositInitiate) {
+		o.force = true
+	}
+}
+
+// MicroDepositInitiate creates a new micro deposit verification for the given bank account. The bank account is fetched
+// first and ErrMicroDepositsAlreadyPending returned, instead of sending more, if micro-deposits were already sent and are
+// pending verification, unless WithForceMicroDeposits is given.
 // https://docs.moov.io/api/sources/bank-accounts/initiate-micro-deposits/
-func (c Client) MicroDepositInitiate(ctx context.Context, accountID string, bankAccountID string) error {
+func (c Client) MicroDepositInitiate(ctx context.Context, accountID string, bankAccountID string, opts ...MicroDepositInitiateOption) error {
+	o := applyOptions(&microDepositInitiate{}, opts)
+
+	if !o.force {
+		bankAccount, err := c.GetBankAccount(ctx, accountID, bankAccountID)
+		if err != nil {
+			return err
+		}
+		if bankAccount.Status == BankAccountStatus_Pending {
+			return fmt.Errorf("%w: bank account %s", ErrMicroDepositsAlreadyPending, bankAccountID)
+		}
+	}
+
 	resp, err := c.CallHttp(ctx, Endpoint(http.MethodPost, pathBankAccountMicroDeposits, accountID, bankAccountID))
 	if err != nil {
 		return err
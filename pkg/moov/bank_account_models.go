@@ -68,11 +68,28 @@ type BankAccount struct {
 	StatusReason          BankAccountStatusReason `json:"statusReason,omitempty"`
 	ExceptionDetails      *ExceptionDetails       `json:"exceptionDetails,omitempty"`
 
+	// How the bank account was or is being verified, if verification was started.
+	Verification *BankAccountVerification `json:"verification,omitempty"`
+
 	// Includes any payment methods generated for a newly created bank account, removing the need to  call the List Payment Methods endpoint following a successful Create BankAccount request.
 	// **NOTE: This field is only populated for Create BankAccount requests made with the `X-Wait-For` header.**
 	PaymentMethods []BasicPaymentMethod `json:"paymentMethods,omitempty"`
 }
 
+// VerificationMethod returns how the bank account was verified, or is being verified if it's still pending. It's empty
+// when verification hasn't been started. Some rails require the account was verified a particular way, such as instantly.
+func (b BankAccount) VerificationMethod() BankAccountVerificationMethod {
+	if b.Verification == nil {
+		return ""
+	}
+	return b.Verification.VerificationMethod
+}
+
+// Verified reports if the bank account has been verified and can be used to move money.
+func (b BankAccount) Verified() bool {
+	return b.Status == BankAccountStatus_Verified
+}
+
 // BankAccountStatus The bank account status.
 type BankAccountStatus string
 
@@ -160,8 +177,9 @@ type BankAccountVerificationMethod string
 
 // List of BankAccountVerificationMethod
 const (
-	BankAccountVerificationMethodInstant BankAccountVerificationMethod = "instant"
-	BankAccountVerificationMethodAch     BankAccountVerificationMethod = "ach"
+	BankAccountVerificationMethodInstant       BankAccountVerificationMethod = "instant"
+	BankAccountVerificationMethodMicroDeposits BankAccountVerificationMethod = "micro-deposits"
+	BankAccountVerificationMethodAch           BankAccountVerificationMethod = "ach"
 )
 
 type BankAccountVerificationStatus string
@@ -1,7 +1,11 @@
 package moov_test
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/moovfinancial/moov-go/pkg/moov"
@@ -67,3 +71,214 @@ func Test_CreateBankAccount_WithBankAccount(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func Test_LinkBankAccountWithPlaid(t *testing.T) {
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/accounts/account-id/bank-accounts", r.URL.Path)
+
+		body := map[string]any{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		if body["plaidLink"].(map[string]any)["publicToken"] == "bad-token" {
+			writeJson(t, w, http.StatusUnprocessableEntity, map[string]string{"error": "INVALID_PUBLIC_TOKEN"})
+			return
+		}
+
+		require.Equal(t, map[string]any{
+			"plaidLink": map[string]any{"publicToken": "public-sandbox-token"},
+		}, body)
+
+		writeJson(t, w, http.StatusOK, moov.BankAccount{
+			BankAccountID: "bank-account-id",
+			Status:        moov.BankAccountStatus_Verified,
+		})
+	}))
+
+	t.Run("verified", func(t *testing.T) {
+		bankAccount, err := mc.LinkBankAccountWithPlaid(context.Background(), "account-id", moov.PlaidLinkRequest{
+			PublicToken: "public-sandbox-token",
+		})
+		require.NoError(t, err)
+		require.Equal(t, moov.BankAccountStatus_Verified, bankAccount.Status)
+	})
+
+	t.Run("missing token", func(t *testing.T) {
+		_, err := mc.LinkBankAccountWithPlaid(context.Background(), "account-id", moov.PlaidLinkRequest{})
+		require.ErrorIs(t, err, moov.ErrPlaidTokenRequired)
+	})
+
+	t.Run("plaid error", func(t *testing.T) {
+		_, err := mc.LinkBankAccountWithPlaid(context.Background(), "account-id", moov.PlaidLinkRequest{
+			PublicToken: "bad-token",
+		})
+		require.ErrorIs(t, err, moov.ErrPlaidLinkFailed)
+		require.Equal(t, moov.StatusFailedValidation, moov.ErrorAsCallResponse(err).Status())
+	})
+}
+
+func Test_ListBankAccounts_WithBankAccountStatus(t *testing.T) {
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/accounts/account-id/bank-accounts", r.URL.Path)
+
+		writeJson(t, w, http.StatusOK, []moov.BankAccount{
+			{BankAccountID: "new", Status: moov.BankAccountStatus_New},
+			{BankAccountID: "verified", Status: moov.BankAccountStatus_Verified},
+			{BankAccountID: "errored", Status: moov.BankAccountStatus_Errored},
+		})
+	}))
+
+	all, err := mc.ListBankAccounts(context.Background(), "account-id")
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+
+	verified, err := mc.ListBankAccounts(context.Background(), "account-id", moov.WithBankAccountStatus(moov.BankAccountStatus_Verified))
+	require.NoError(t, err)
+	require.Len(t, verified, 1)
+	require.Equal(t, "verified", verified[0].BankAccountID)
+
+	attention, err := mc.ListBankAccounts(context.Background(), "account-id",
+		moov.WithBankAccountStatus(moov.BankAccountStatus_Errored, moov.BankAccountStatus_VerificationFailed))
+	require.NoError(t, err)
+	require.Len(t, attention, 1)
+	require.Equal(t, "errored", attention[0].BankAccountID)
+}
+
+func Test_FindBankAccount(t *testing.T) {
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/accounts/account-id/bank-accounts", r.URL.Path)
+
+		writeJson(t, w, http.StatusOK, []moov.BankAccount{
+			{BankAccountID: "checking", RoutingNumber: "273976369", LastFourAccountNumber: "6789"},
+			{BankAccountID: "savings", RoutingNumber: "273976369", LastFourAccountNumber: "1234"},
+			{BankAccountID: "other-bank", RoutingNumber: "011000015", LastFourAccountNumber: "1234"},
+		})
+	}))
+
+	t.Run("match", func(t *testing.T) {
+		bankAccount, err := mc.FindBankAccount(context.Background(), "account-id", "273976369", "1234")
+		require.NoError(t, err)
+		require.Equal(t, "savings", bankAccount.BankAccountID)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		_, err := mc.FindBankAccount(context.Background(), "account-id", "011000015", "6789")
+		require.ErrorIs(t, err, moov.ErrBankAccountNotFound)
+	})
+}
+
+func Test_MicroDepositInitiate_AlreadyPending(t *testing.T) {
+	fetched, initiated := 0, 0
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/accounts/account-id/bank-accounts/bank-account-id":
+			fetched++
+			writeJson(t, w, http.StatusOK, moov.BankAccount{BankAccountID: "bank-account-id", Status: moov.BankAccountStatus_Pending})
+		case r.Method == http.MethodPost && r.URL.Path == "/accounts/account-id/bank-accounts/bank-account-id/micro-deposits":
+			initiated++
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	err := mc.MicroDepositInitiate(BgCtx(), "account-id", "bank-account-id")
+	require.ErrorIs(t, err, moov.ErrMicroDepositsAlreadyPending)
+	require.Zero(t, initiated)
+
+	// Sent without fetching the bank account when forced
+	err = mc.MicroDepositInitiate(BgCtx(), "account-id", "bank-account-id", moov.WithForceMicroDeposits())
+	require.NoError(t, err)
+	require.Equal(t, 1, initiated)
+	require.Equal(t, 1, fetched)
+}
+
+func Test_BankAccount_VerificationMethod(t *testing.T) {
+	cases := []struct {
+		name     string
+		fixture  string
+		method   moov.BankAccountVerificationMethod
+		verified bool
+	}{
+		{
+			name: "instant",
+			fixture: `{
+				"bankAccountID": "ec7e1848-dc80-4ab0-8827-dd7fc0737b43",
+				"fingerprint": "9948962d92a1ce40c9f918cd9ece3a22bde62fb325a2f1fe2e833969de672ba3",
+				"status": "verified",
+				"holderName": "Jules Jackson",
+				"holderType": "individual",
+				"bankName": "Chase Bank",
+				"bankAccountType": "checking",
+				"routingNumber": "021000021",
+				"lastFourAccountNumber": "7890",
+				"updatedOn": "2024-05-02T09:15:42Z",
+				"statusReason": "verification-successful",
+				"verification": {"verificationMethod": "instant", "status": "successful", "exceptionDetails": null}
+			}`,
+			method:   moov.BankAccountVerificationMethodInstant,
+			verified: true,
+		},
+		{
+			name: "micro-deposits",
+			fixture: `{
+				"bankAccountID": "ec7e1848-dc80-4ab0-8827-dd7fc0737b43",
+				"status": "pending",
+				"holderName": "Jules Jackson",
+				"holderType": "individual",
+				"bankAccountType": "savings",
+				"routingNumber": "021000021",
+				"lastFourAccountNumber": "7890",
+				"statusReason": "verification-initiated",
+				"verification": {"verificationMethod": "micro-deposits", "status": "new", "exceptionDetails": null}
+			}`,
+			method:   moov.BankAccountVerificationMethodMicroDeposits,
+			verified: false,
+		},
+		{
+			name: "ach",
+			fixture: `{
+				"bankAccountID": "ec7e1848-dc80-4ab0-8827-dd7fc0737b43",
+				"status": "verified",
+				"holderName": "Whole Body Fitness",
+				"holderType": "business",
+				"bankAccountType": "checking",
+				"routingNumber": "021000021",
+				"lastFourAccountNumber": "7890",
+				"statusReason": "verification-successful",
+				"verification": {"verificationMethod": "ach", "status": "successful", "exceptionDetails": null}
+			}`,
+			method:   moov.BankAccountVerificationMethodAch,
+			verified: true,
+		},
+		{
+			name: "unverified",
+			fixture: `{
+				"bankAccountID": "ec7e1848-dc80-4ab0-8827-dd7fc0737b43",
+				"status": "new",
+				"holderName": "Jules Jackson",
+				"holderType": "individual",
+				"bankAccountType": "checking",
+				"routingNumber": "021000021",
+				"lastFourAccountNumber": "7890",
+				"statusReason": "bank-account-created"
+			}`,
+			method:   "",
+			verified: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var bankAccount moov.BankAccount
+
+			dec := json.NewDecoder(bytes.NewReader([]byte(tc.fixture)))
+			dec.DisallowUnknownFields()
+			require.NoError(t, dec.Decode(&bankAccount))
+
+			require.Equal(t, tc.method, bankAccount.VerificationMethod())
+			require.Equal(t, tc.verified, bankAccount.Verified())
+		})
+	}
+}
@@ -3,11 +3,17 @@ package moov
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
 	"net/textproto"
+	"slices"
 	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 type CallStatus struct {
@@ -44,16 +50,36 @@ type callBuilder struct {
 	path   string
 	params map[string]string
 
+	// Path before IDs were filled in, identifying the endpoint regardless of the resource called
+	endpoint string
+	// Name of the business operation the call is for, see WithOperationName
+	operationName string
+
 	headers map[string]string
 	token   *string
 
 	body io.Reader
+
+	// Logical operation the call is part of, used to look up its idempotency key.
+	operationID string
+	// Send the call without an idempotency key, even one set by default.
+	withoutIdempotencyKey bool
+
+	// Limits how long the call can take, on top of any deadline of the caller's context.
+	timeout time.Duration
+
+	// Receives a successful response's body instead of it being buffered, see CallHttpReader.
+	sink io.Writer
+
+	// The call only reads, even though it isn't a GET, so it's still sent in a dry run.
+	readOnly bool
 }
 
 func newCall(endpoint EndpointArg, args ...callArg) (*callBuilder, error) {
 	call := &callBuilder{
-		params:  make(map[string]string),
-		headers: make(map[string]string),
+		params: make(map[string]string),
+		// JSON unless the call asks for another format, such as with AcceptCSV
+		headers: map[string]string{"Accept": "application/json"},
 	}
 
 	args = prependArgs(args, endpoint)
@@ -99,6 +125,7 @@ type EndpointArg callArg
 func Endpoint(method string, pathFmt string, args ...any) EndpointArg {
 	return callBuilderFn(func(call *callBuilder) error {
 		call.method = method
+		call.endpoint = pathFmt
 		call.path = fmt.Sprintf(pathFmt, args...)
 
 		return nil
@@ -169,6 +196,14 @@ func MultipartBody(parts ...multipartFn) callArg {
 	})
 }
 
+// readOnly marks a call that doesn't change anything though Moov takes it as a POST, like listing transfer options.
+func readOnly() callArg {
+	return callBuilderFn(func(call *callBuilder) error {
+		call.readOnly = true
+		return nil
+	})
+}
+
 func NoopArg() callArg {
 	return callBuilderFn(func(call *callBuilder) error {
 		return nil
@@ -182,6 +217,32 @@ func AcceptJson() callArg {
 	})
 }
 
+// AcceptCSV asks for a CSV export of the endpoint's results, which is best read with CallHttpReader.
+func AcceptCSV() callArg {
+	return callBuilderFn(func(call *callBuilder) error {
+		call.headers["Accept"] = "text/csv"
+		return nil
+	})
+}
+
+// ActingAccountHeader identifies the partner account a platform is making a call on behalf of. Moov's API reference
+// doesn't document this header, calls are otherwise scoped by the credentials and the account in their path, so check
+// it's honored for your platform before relying on it.
+const ActingAccountHeader = "X-Moov-Account-ID"
+
+// WithActingAccount sets the partner account the call is made on behalf of in ActingAccountHeader, which isn't sent
+// unless asked for. The account ID must be a UUID.
+func WithActingAccount(accountID string) callArg {
+	return callBuilderFn(func(call *callBuilder) error {
+		if err := uuid.Validate(accountID); err != nil {
+			return fmt.Errorf("acting account %q must be a UUID: %w", accountID, err)
+		}
+
+		call.headers[ActingAccountHeader] = accountID
+		return nil
+	})
+}
+
 func WaitFor(state string) callArg {
 	return callBuilderFn(func(call *callBuilder) error {
 		call.headers["X-Wait-For"] = state
@@ -189,13 +250,79 @@ func WaitFor(state string) callArg {
 	})
 }
 
+// orderBy sorts a listing by field, which must be one of allowed, as the `field:asc` or `field:desc` Moov expects.
+func orderBy(field string, desc bool, allowed ...string) callArg {
+	return callBuilderFn(func(call *callBuilder) error {
+		if !slices.Contains(allowed, field) {
+			return fmt.Errorf("can't order by %q, must be one of %s", field, strings.Join(allowed, ", "))
+		}
+
+		direction := "asc"
+		if desc {
+			direction = "desc"
+		}
+		call.params["orderBy"] = field + ":" + direction
+		return nil
+	})
+}
+
+// WithTimeout limits how long a single call can take. It can only shorten the deadline of the context passed in, never
+// extend it. It's accepted anywhere a call's filters or options are.
+func WithTimeout(timeout time.Duration) callArg {
+	return callBuilderFn(func(call *callBuilder) error {
+		call.timeout = timeout
+		return nil
+	})
+}
+
+// WithQueryParam adds a query parameter the SDK doesn't model yet, such as a filter Moov recently added. It's accepted
+// anywhere a call's filters or options are and replaces a parameter of the same key set before it.
+func WithQueryParam(key, value string) callArg {
+	return callBuilderFn(func(call *callBuilder) error {
+		if key == "" {
+			return errors.New("query param key must not be empty")
+		}
+
+		call.params[key] = value
+		return nil
+	})
+}
+
+// IdempotencyKey sets the call's idempotency key, sent in IdempotencyKeyHeader or the header set WithIdempotencyHeader.
 func IdempotencyKey(uuid string) callArg {
 	return callBuilderFn(func(call *callBuilder) error {
-		call.headers["X-Idempotency-Key"] = uuid
+		call.headers[IdempotencyKeyHeader] = uuid
 		return nil
 	})
 }
 
+// WithoutIdempotencyKey sends a create without the X-Idempotency-Key header the SDK otherwise generates, for callers that
+// handle idempotency upstream. Without a key, calls the SDK would otherwise retry, such as after re-authenticating, are
+// sent once. It can't be combined with an operation ID, which needs a key to remember.
+func WithoutIdempotencyKey() callArg {
+	return callBuilderFn(func(call *callBuilder) error {
+		call.withoutIdempotencyKey = true
+		return nil
+	})
+}
+
+// WithOperationName names the call after what it's doing, such as "checkout-charge", so it can be picked out in traces
+// and metrics. Calls are named after their method and endpoint by default, such as "POST /accounts/%s/transfers".
+func WithOperationName(name string) callArg {
+	return callBuilderFn(func(call *callBuilder) error {
+		call.operationName = name
+		return nil
+	})
+}
+
+// operation returns the name given with WithOperationName or the call's method and endpoint.
+func (call *callBuilder) operation() string {
+	if call.operationName != "" {
+		return call.operationName
+	}
+	return call.method + " " + call.endpoint
+}
+
 func Skip(skip int) ListTransferFilter {
 	return callBuilderFn(func(call *callBuilder) error {
 		call.params["skip"] = fmt.Sprintf("%d", skip)
@@ -277,6 +404,82 @@ func CompletedListOrError[A interface{}](resp CallResponse) ([]A, error) {
 	}
 }
 
+// filterList keeps the items matching every filter, for list endpoints that can only be filtered client-side
+func filterList[A interface{}, F ~func(A) bool](items []A, filters []F) []A {
+	if len(filters) == 0 {
+		return items
+	}
+
+	kept := make([]A, 0, len(items))
+items:
+	for _, item := range items {
+		for _, filter := range filters {
+			if !filter(item) {
+				continue items
+			}
+		}
+		kept = append(kept, item)
+	}
+	return kept
+}
+
+// listPageSize is how many items are requested at a time when paging through everything a listing matches
+const listPageSize = 200
+
+// forEachPage pages through a listing, calling page with each page of items until one comes back short. list requests
+// the count items after skipping the first skip of them.
+func forEachPage[A interface{}](list func(skip, count int) ([]A, error), page func(items []A) error) error {
+	for skip := 0; ; skip += listPageSize {
+		items, err := list(skip, listPageSize)
+		if err != nil {
+			return err
+		}
+		if err := page(items); err != nil {
+			return err
+		}
+		if len(items) < listPageSize {
+			return nil
+		}
+	}
+}
+
+// listAllPages collects the items on every page of a listing that match all the filters, see forEachPage
+func listAllPages[A interface{}](list func(skip, count int) ([]A, error), filters ...func(A) bool) ([]A, error) {
+	var all []A
+	err := forEachPage(list, func(items []A) error {
+		all = append(all, filterList(items, filters)...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
+
+// fanOut calls fn with each index up to n, running at most limit of the calls at once, and returns each call's error at
+// its index.
+func fanOut(n, limit int, fn func(i int) error) []error {
+	errs := make([]error, n)
+	sem := make(chan struct{}, limit)
+
+	var wg sync.WaitGroup
+	for i := range n {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			errs[i] = fn(i)
+		}()
+	}
+	wg.Wait()
+
+	return errs
+}
+
 var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
 
 func escapeQuotes(s string) string {
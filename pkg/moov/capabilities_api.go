@@ -2,7 +2,9 @@ package moov
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"slices"
 )
 
 // RequestCapabilities adds a new capability for the given account
@@ -37,6 +39,17 @@ func (c Client) ListCapabilities(ctx context.Context, accountID string) ([]Capab
 	return CompletedListOrError[Capability](resp)
 }
 
+// CapabilitiesSummary returns which of the account's capabilities are enabled or pending along with everything that's
+// still outstanding across all of them.
+func (c Client) CapabilitiesSummary(ctx context.Context, accountID string) (CapabilitiesSummary, error) {
+	capabilities, err := c.ListCapabilities(ctx, accountID)
+	if err != nil {
+		return CapabilitiesSummary{}, err
+	}
+
+	return summarizeCapabilities(capabilities), nil
+}
+
 // GetCapability returns a given capability for a given account
 func (c Client) GetCapability(ctx context.Context, accountID string, capability CapabilityName) (*Capability, error) {
 	resp, err := c.CallHttp(ctx,
@@ -49,12 +62,40 @@ func (c Client) GetCapability(ctx context.Context, accountID string, capability
 	return CompletedObjectOrError[Capability](resp)
 }
 
-// DisableCapability disables a specific capability
-func (c Client) DisableCapability(ctx context.Context, accountID string, capability CapabilityName) error {
-	resp, err := c.CallHttp(ctx, Endpoint(http.MethodDelete, pathCapability, accountID, capability))
-	if err != nil {
-		return err
+// WaitForCapability gets the capability every DefaultPollInterval until it reaches one of the target statuses, enabled
+// if none are given. A capability that's disabled instead is returned with ErrCapabilityDisabled. If the context ends
+// while the capability is still pending a *CapabilityPendingError lists the requirements it's waiting on.
+func (c Client) WaitForCapability(ctx context.Context, accountID string, capability CapabilityName, target ...CapabilityStatus) (*Capability, error) {
+	if len(target) == 0 {
+		target = []CapabilityStatus{CapabilityStatus_Enabled}
 	}
 
-	return CompletedNilOrError(resp)
+	got, err := poll(ctx, DefaultPollInterval, func(ctx context.Context) (*Capability, bool, error) {
+		got, err := c.GetCapability(ctx, accountID, capability)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return got, slices.Contains(target, got.Status) || got.Status == CapabilityStatus_Disabled, nil
+	})
+
+	switch {
+	case got == nil:
+		return nil, err
+	case err != nil && got.Status == CapabilityStatus_Pending:
+		return got, &CapabilityPendingError{
+			Capability:   capability,
+			Requirements: got.OutstandingRequirements(),
+			Err:          err,
+		}
+	case err == nil && got.Status == CapabilityStatus_Disabled && !slices.Contains(target, CapabilityStatus_Disabled):
+		return got, fmt.Errorf("%w: %s %s", ErrCapabilityDisabled, capability, got.DisabledReason)
+	default:
+		return got, err
+	}
+}
+
+// DisableCapability disables a specific capability
+func (c Client) DisableCapability(ctx context.Context, accountID string, capability CapabilityName) error {
+	return c.delete(ctx, Endpoint(http.MethodDelete, pathCapability, accountID, capability))
 }
@@ -1,6 +1,10 @@
 package moov
 
-import "time"
+import (
+	"slices"
+	"strings"
+	"time"
+)
 
 type requestCapabilities struct {
 	Capabilities []CapabilityName `json:"capabilities"`
@@ -45,8 +49,70 @@ const (
 
 // Requirement Represents individual and business data necessary to facilitate the enabling of a capability for an account.
 type Requirement struct {
-	CurrentlyDue []RequirementId    `json:"currentlyDue,omitempty"`
-	Errors       []RequirementError `json:"errors,omitempty"`
+	// Requirements that must be provided before the capability can be enabled.
+	CurrentlyDue []RequirementId `json:"currentlyDue,omitempty"`
+	// Requirements that will be needed in the future but aren't yet blocking the capability.
+	EventuallyDue []RequirementId    `json:"eventuallyDue,omitempty"`
+	Errors        []RequirementError `json:"errors,omitempty"`
+}
+
+// OutstandingRequirements lists everything currently due along with any requirement that errored and needs to be
+// resubmitted. Each requirement is only listed once.
+func (c Capability) OutstandingRequirements() []RequirementId {
+	var outstanding []RequirementId
+	for _, id := range c.Requirements.CurrentlyDue {
+		if !slices.Contains(outstanding, id) {
+			outstanding = append(outstanding, id)
+		}
+	}
+	for _, e := range c.Requirements.Errors {
+		if !slices.Contains(outstanding, e.Requirement) {
+			outstanding = append(outstanding, e.Requirement)
+		}
+	}
+	return outstanding
+}
+
+// DocumentErrors lists the requirement errors caused by an uploaded document, such as one that's corrupt or doesn't
+// match the profile, which need a new document uploaded rather than the profile corrected.
+func (c Capability) DocumentErrors() []RequirementError {
+	var docErrors []RequirementError
+	for _, e := range c.Requirements.Errors {
+		if e.ErrorCode.IsDocument() {
+			docErrors = append(docErrors, e)
+		}
+	}
+	return docErrors
+}
+
+// CapabilitiesSummary is a compact view of an account's capabilities for rendering what's left to onboard.
+type CapabilitiesSummary struct {
+	Enabled  []CapabilityName
+	Pending  []CapabilityName
+	Disabled []CapabilityName
+	// Outstanding requirements across every capability, each requirement is only listed once.
+	OutstandingRequirements []RequirementId
+}
+
+func summarizeCapabilities(capabilities []Capability) CapabilitiesSummary {
+	summary := CapabilitiesSummary{}
+	for _, c := range capabilities {
+		switch c.Status {
+		case CapabilityStatus_Enabled:
+			summary.Enabled = append(summary.Enabled, c.Capability)
+		case CapabilityStatus_Pending:
+			summary.Pending = append(summary.Pending, c.Capability)
+		case CapabilityStatus_Disabled:
+			summary.Disabled = append(summary.Disabled, c.Capability)
+		}
+
+		for _, id := range c.OutstandingRequirements() {
+			if !slices.Contains(summary.OutstandingRequirements, id) {
+				summary.OutstandingRequirements = append(summary.OutstandingRequirements, id)
+			}
+		}
+	}
+	return summary
 }
 
 // RequirementId The unique ID of what the requirement is asking to be filled out.
@@ -131,3 +197,8 @@ const (
 	RequirementErrorCode_DocumentCorrupt             RequirementErrorCode = "document-corrupt"
 	RequirementErrorCode_DocumentExpired             RequirementErrorCode = "document-expired"
 )
+
+// IsDocument reports whether the error is about an uploaded document rather than a profile value
+func (c RequirementErrorCode) IsDocument() bool {
+	return strings.HasPrefix(string(c), "document-")
+}
@@ -1,7 +1,12 @@
 package moov_test
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/moovfinancial/moov-go/pkg/moov"
 	"github.com/stretchr/testify/require"
@@ -42,3 +47,166 @@ func Test_Capabilities(t *testing.T) {
 		NoResponseError(t, err)
 	})
 }
+
+func Test_CapabilityRequirements(t *testing.T) {
+	input := []byte(`{
+		"capability": "transfers",
+		"accountID": "ec7e1848-dc80-4ab0-8827-dd7fc0737b43",
+		"status": "pending",
+		"requirements": {
+			"currentlyDue": [
+				"business.ein",
+				"business.address",
+				"representative.{rep-uuid}.ssn",
+				"individual.birthdate"
+			],
+			"eventuallyDue": [
+				"business.average-monthly-transaction-volume"
+			],
+			"errors": [
+				{"requirement": "business.address", "errorCode": "invalid-address"},
+				{"requirement": "individual.ssn", "errorCode": "failed-automatic-verification"}
+			]
+		},
+		"createdOn": "2024-01-01T00:00:00Z",
+		"updatedOn": "2024-01-01T00:00:00Z"
+	}`)
+
+	capability := new(moov.Capability)
+
+	dec := json.NewDecoder(bytes.NewReader(input))
+	dec.DisallowUnknownFields()
+	require.NoError(t, dec.Decode(capability))
+
+	require.Equal(t, []moov.RequirementId{moov.RequirementId_Business_AverageMonthlyTransactionVolume}, capability.Requirements.EventuallyDue)
+	require.Equal(t, []moov.RequirementId{
+		moov.RequirementId_Business_Ein,
+		moov.RequirementId_Business_Address,
+		moov.RequirementId_Representative_Ssn,
+		moov.RequirementId_Individual_BirthDate,
+		moov.RequirementId_Individual_Ssn,
+	}, capability.OutstandingRequirements())
+}
+
+func Test_Capability_DocumentErrors(t *testing.T) {
+	input := []byte(`{
+		"capability": "collect-funds",
+		"accountID": "ec7e1848-dc80-4ab0-8827-dd7fc0737b43",
+		"status": "pending",
+		"requirements": {
+			"currentlyDue": [
+				"document.{doc-uuid}"
+			],
+			"errors": [
+				{"requirement": "business.website", "errorCode": "invalid-value"},
+				{"requirement": "document.{doc-uuid}", "errorCode": "document-corrupt"}
+			]
+		},
+		"createdOn": "2024-01-01T00:00:00Z",
+		"updatedOn": "2024-01-01T00:00:00Z"
+	}`)
+
+	capability := new(moov.Capability)
+
+	dec := json.NewDecoder(bytes.NewReader(input))
+	dec.DisallowUnknownFields()
+	require.NoError(t, dec.Decode(capability))
+
+	require.Equal(t, []moov.RequirementError{
+		{Requirement: moov.RequirementId_Document, ErrorCode: moov.RequirementErrorCode_DocumentCorrupt},
+	}, capability.DocumentErrors())
+}
+
+func Test_CapabilitiesSummary(t *testing.T) {
+	fixture := []byte(`[
+		{
+			"capability": "transfers",
+			"status": "enabled",
+			"createdOn": "2024-01-01T00:00:00Z",
+			"updatedOn": "2024-01-01T00:00:00Z"
+		},
+		{
+			"capability": "send-funds",
+			"status": "pending",
+			"requirements": {
+				"currentlyDue": ["business.ein", "business.address", "representative.{rep-uuid}.ssn"],
+				"errors": [{"requirement": "business.address", "errorCode": "invalid-address"}]
+			},
+			"createdOn": "2024-01-01T00:00:00Z",
+			"updatedOn": "2024-01-01T00:00:00Z"
+		},
+		{
+			"capability": "collect-funds",
+			"status": "pending",
+			"requirements": {
+				"currentlyDue": ["business.ein", "business.industry-code-mcc"],
+				"errors": [{"requirement": "representative.{rep-uuid}.ssn", "errorCode": "failed-automatic-verification"}]
+			},
+			"createdOn": "2024-01-01T00:00:00Z",
+			"updatedOn": "2024-01-01T00:00:00Z"
+		}
+	]`)
+
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/accounts/account-id/capabilities", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(fixture)
+	}))
+
+	summary, err := mc.CapabilitiesSummary(BgCtx(), "account-id")
+	require.NoError(t, err)
+
+	require.Equal(t, []moov.CapabilityName{moov.CapabilityName_Transfers}, summary.Enabled)
+	require.Equal(t, []moov.CapabilityName{moov.CapabilityName_SendFunds, moov.CapabilityName_CollectFunds}, summary.Pending)
+	require.Empty(t, summary.Disabled)
+	require.Equal(t, []moov.RequirementId{
+		moov.RequirementId_Business_Ein,
+		moov.RequirementId_Business_Address,
+		moov.RequirementId_Representative_Ssn,
+		moov.RequirementId_Business_IndustryCodeMcc,
+	}, summary.OutstandingRequirements)
+}
+
+func Test_WaitForCapability(t *testing.T) {
+	gets := 0
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/accounts/account-id/capabilities/transfers", r.URL.Path)
+
+		capability := moov.Capability{
+			Capability: moov.CapabilityName_Transfers,
+			Status:     moov.CapabilityStatus_Pending,
+			Requirements: moov.Requirement{
+				CurrentlyDue: []moov.RequirementId{moov.RequirementId_Account_TosAcceptance},
+			},
+		}
+		if gets > 0 {
+			capability.Status = moov.CapabilityStatus_Enabled
+			capability.Requirements = moov.Requirement{}
+		}
+		gets++
+
+		writeJson(t, w, http.StatusOK, capability)
+	}))
+
+	t.Run("pending to enabled", func(t *testing.T) {
+		capability, err := mc.WaitForCapability(BgCtx(), "account-id", moov.CapabilityName_Transfers)
+		require.NoError(t, err)
+		require.Equal(t, moov.CapabilityStatus_Enabled, capability.Status)
+		require.Equal(t, 2, gets)
+	})
+
+	t.Run("stalled in pending", func(t *testing.T) {
+		gets = 0
+		ctx, cancel := context.WithTimeout(BgCtx(), 100*time.Millisecond)
+		defer cancel()
+
+		capability, err := mc.WaitForCapability(ctx, "account-id", moov.CapabilityName_Transfers)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Equal(t, moov.CapabilityStatus_Pending, capability.Status)
+
+		var pending *moov.CapabilityPendingError
+		require.ErrorAs(t, err, &pending)
+		require.Equal(t, []moov.RequirementId{moov.RequirementId_Account_TosAcceptance}, pending.Requirements)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"slices"
 	"time"
 )
 
@@ -62,6 +63,13 @@ type CardDetails struct {
 	FailedOn                 *time.Time            `json:"failedOn,omitempty"`
 	CanceledOn               *time.Time            `json:"canceledOn,omitempty"`
 	CompletedOn              *time.Time            `json:"completedOn,omitempty"`
+
+	// Level 2 and 3 purchase data applied to the transfer, see InterchangeQualification for the rate it qualified for.
+	Level2 *CardLevel2 `json:"level2,omitempty"`
+	Level3 *CardLevel3 `json:"level3,omitempty"`
+
+	// Amount the issuer authorized, which is less than the transfer amount when the card was only partially authorized.
+	AuthorizedAmount *Amount `json:"authorizedAmount,omitempty"`
 }
 
 // CardTransactionStatus represents the status of a card transaction within a Transfer
@@ -120,15 +128,33 @@ func (c Client) CreateCard(ctx context.Context, accountID string, card CreateCar
 	}
 }
 
-// ListCards lists all cards for the given customer Moov account
+// CardFilter narrows the cards returned by ListCards. Moov's list endpoint doesn't support filtering, so filters are
+// applied client-side to the full list.
+type CardFilter func(card Card) bool
+
+// WithCardVerification keeps cards where the CVV, address line, or postal code check has the given result. Cards have
+// no status of their own, so WithCardVerification(CardVerificationResult_NoMatch) finds the cards needing attention.
+func WithCardVerification(result CardVerificationResult) CardFilter {
+	return func(card Card) bool {
+		v := card.CardVerification
+		return slices.Contains([]string{v.Cvv, v.AddressLine1, v.PostalCode}, string(result))
+	}
+}
+
+// ListCards lists all cards for the given customer Moov account, keeping those matching every filter
 // https://docs.moov.io/api/#tag/Cards/operation/listCards
-func (c Client) ListCards(ctx context.Context, accountID string) ([]Card, error) {
+func (c Client) ListCards(ctx context.Context, accountID string, filters ...CardFilter) ([]Card, error) {
 	resp, err := c.CallHttp(ctx, Endpoint(http.MethodGet, pathCards, accountID))
 	if err != nil {
 		return nil, err
 	}
 
-	return CompletedListOrError[Card](resp)
+	cards, err := CompletedListOrError[Card](resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterList(cards, filters), nil
 }
 
 // GetCard retrieves a card for the given customer Moov account
@@ -251,10 +277,5 @@ func (c Client) UpdateCard(ctx context.Context, accountID string, cardID string,
 // DisableCard disables a card associated with a Moov account
 // https://docs.moov.io/api/#tag/Cards/operation/deleteCard
 func (c Client) DisableCard(ctx context.Context, accountID string, cardID string) error {
-	resp, err := c.CallHttp(ctx, Endpoint(http.MethodDelete, pathCard, accountID, cardID))
-	if err != nil {
-		return err
-	}
-
-	return CompletedNilOrError(resp)
+	return c.delete(ctx, Endpoint(http.MethodDelete, pathCard, accountID, cardID))
 }
@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"net/http"
 	"testing"
 	"time"
 
@@ -189,3 +190,23 @@ func newTestCard(t *testing.T, mc *moov.Client) testCard {
 		card:    *card,
 	}
 }
+
+func TestListCards_WithCardVerification(t *testing.T) {
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/accounts/account-id/cards", r.URL.Path)
+
+		writeJson(t, w, http.StatusOK, []moov.Card{
+			{CardID: "matched", CardVerification: moov.CardVerification{Cvv: "match", AddressLine1: "match", PostalCode: "match"}},
+			{CardID: "bad-zip", CardVerification: moov.CardVerification{Cvv: "match", AddressLine1: "match", PostalCode: "noMatch"}},
+		})
+	}))
+
+	all, err := mc.ListCards(context.Background(), "account-id")
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+
+	attention, err := mc.ListCards(context.Background(), "account-id", moov.WithCardVerification(moov.CardVerificationResult_NoMatch))
+	require.NoError(t, err)
+	require.Len(t, attention, 1)
+	require.Equal(t, "bad-zip", attention[0].CardID)
+}
@@ -0,0 +1,85 @@
+package moov
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ChargeCardRequest describes a customer paying a merchant with a card
+type ChargeCardRequest struct {
+	// Account the transfer is created under, usually the platform account facilitating the payment
+	PartnerAccountID string
+	// The customer's card-payment payment method
+	SourcePaymentMethodID string
+	// The merchant's wallet payment method. Set either this or DestinationAccountID.
+	DestinationPaymentMethodID string
+	// The merchant's account, whose moov-wallet payment method is looked up when DestinationPaymentMethodID isn't set
+	DestinationAccountID string
+	// Left without a currency, the client's WithDefaultCurrency is used
+	Amount Amount
+	// Optional fee the platform collects from the merchant
+	FacilitatorFee CreateTransfer_FacilitatorFee
+	// Optional override of the merchant's statement descriptor on the customer's card statement
+	DynamicDescriptor string
+	Description       string
+	Metadata          map[string]string
+}
+
+// ChargeCard creates a card payment from a customer's card to a merchant's wallet and waits for the card network to
+// approve or decline it. A declined charge isn't an error, the returned transfer has a failed status and its
+// CardDetails explain why. If the network doesn't respond in time ErrRailResponsePending is returned along with the
+// transfer as it currently is.
+func (c Client) ChargeCard(ctx context.Context, req ChargeCardRequest) (*Transfer, error) {
+	if req.SourcePaymentMethodID == "" {
+		return nil, errors.New("source payment method is required")
+	}
+
+	destination := req.DestinationPaymentMethodID
+	if destination == "" {
+		if req.DestinationAccountID == "" {
+			return nil, errors.New("destination payment method or account is required")
+		}
+
+		wallets, err := c.ListPaymentMethods(ctx, req.DestinationAccountID, WithPaymentMethodType(string(PaymentMethodType_MoovWallet)))
+		if err != nil {
+			return nil, err
+		}
+		if len(wallets) == 0 {
+			return nil, fmt.Errorf("%w: account %s has no %s", ErrPaymentMethodNotEnabled, req.DestinationAccountID, PaymentMethodType_MoovWallet)
+		}
+		destination = wallets[0].PaymentMethodID
+	}
+
+	transfer := CreateTransfer{
+		Source: CreateTransfer_Source{
+			PaymentMethodID: req.SourcePaymentMethodID,
+		},
+		Destination: CreateTransfer_Destination{
+			PaymentMethodID: destination,
+		},
+		Amount:         req.Amount,
+		FacilitatorFee: req.FacilitatorFee,
+		Description:    req.Description,
+		Metadata:       req.Metadata,
+	}
+	if req.DynamicDescriptor != "" {
+		transfer.Source.CardDetails = &CreateTransfer_CardDetailsSource{
+			DynamicDescriptor: req.DynamicDescriptor,
+		}
+	}
+
+	completed, started, err := c.CreateTransfer(ctx, req.PartnerAccountID, transfer).WaitForRailResponse()
+	if err != nil {
+		return nil, err
+	}
+	if completed != nil {
+		return completed, nil
+	}
+
+	current, err := c.GetTransfer(ctx, req.PartnerAccountID, started.TransferID)
+	if err != nil {
+		return nil, errors.Join(ErrRailResponsePending, err)
+	}
+	return current, ErrRailResponsePending
+}
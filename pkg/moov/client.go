@@ -1,8 +1,13 @@
 package moov
 
 import (
+	"context"
+	"fmt"
 	"io"
 	"net/http"
+	"strings"
+	"sync"
+	"time"
 )
 
 type Client struct {
@@ -10,6 +15,46 @@ type Client struct {
 	HttpClient  *http.Client
 
 	decoder Decoder
+
+	transferLimits *transferLimits
+
+	// Currency of transfers created without one, see WithDefaultCurrency
+	defaultCurrency string
+
+	validateCredentials bool
+
+	idempotencyStore IdempotencyStore
+	// Header idempotency keys are sent in, see WithIdempotencyHeader
+	idempotencyHeader string
+
+	trace *httpTrace
+
+	tokenAuth *tokenAuth
+
+	deprecations *deprecations
+
+	retry *retryPolicy
+
+	metricsObserver func(Metric)
+
+	institutionCache *institutionCache
+
+	dryRun *dryRun
+
+	// Largest response body read, zero for no limit
+	maxResponseBytes int64
+
+	// Prefix added to the path of every call, such as when Moov is behind an API gateway.
+	basePath string
+
+	lifecycle *clientLifecycle
+}
+
+// clientLifecycle is shared between copies of a Client so closing any of them ends calls made through all of them.
+type clientLifecycle struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	once   sync.Once
 }
 
 // NewClient returns a moov.Client with credentials read from environment variables.
@@ -18,8 +63,16 @@ func NewClient(configurables ...ClientConfigurable) (*Client, error) {
 	client := &Client{
 		Credentials: CredentialsFromEnv(),
 		HttpClient:  DefaultHttpClient(),
+
+		idempotencyStore:  NewMemoryIdempotencyStore(),
+		idempotencyHeader: IdempotencyKeyHeader,
+		deprecations:      &deprecations{observer: logDeprecation},
+		maxResponseBytes:  DefaultMaxResponseBytes,
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
+	client.lifecycle = &clientLifecycle{ctx: ctx, cancel: cancel}
+
 	// Apply all the configurable functions to the client
 	for _, configurable := range configurables {
 		if err := configurable(client); err != nil {
@@ -32,9 +85,32 @@ func NewClient(configurables ...ClientConfigurable) (*Client, error) {
 		return nil, err
 	}
 
+	if client.validateCredentials {
+		ctx, cancel := context.WithTimeout(client.lifecycle.ctx, validateCredentialsTimeout)
+		defer cancel()
+
+		if err := client.Ping(ctx); err != nil {
+			return nil, fmt.Errorf("validating credentials: %w", err)
+		}
+	}
+
 	return client, nil
 }
 
+// Close cancels any calls still in flight, rejects new calls with ErrClientClosed, and closes idle connections held by
+// the HttpClient. It's safe to call more than once and from multiple goroutines.
+func (c *Client) Close() error {
+	if c.lifecycle != nil {
+		c.lifecycle.once.Do(c.lifecycle.cancel)
+	}
+
+	if c.HttpClient != nil {
+		c.HttpClient.CloseIdleConnections()
+	}
+
+	return nil
+}
+
 type ClientConfigurable func(c *Client) error
 
 func WithCredentials(credentials Credentials) ClientConfigurable {
@@ -51,6 +127,31 @@ func WithHttpClient(client *http.Client) ClientConfigurable {
 	}
 }
 
+const validateCredentialsTimeout = 30 * time.Second
+
+// WithValidateCredentials pings Moov while creating the client so invalid credentials are caught at startup instead of
+// on the first call. This is opt-in as it makes a network call.
+func WithValidateCredentials() ClientConfigurable {
+	return func(c *Client) error {
+		c.validateCredentials = true
+		return nil
+	}
+}
+
+// WithBasePath prefixes the path of every call, for when Moov is reached through an API gateway that routes on a prefix
+// such as /moov. The host is still taken from the credentials.
+func WithBasePath(prefix string) ClientConfigurable {
+	return func(c *Client) error {
+		prefix = strings.Trim(prefix, "/")
+		if prefix != "" {
+			prefix = "/" + prefix
+		}
+
+		c.basePath = prefix
+		return nil
+	}
+}
+
 type Decoder func(r io.Reader, contentType string, item any) error
 
 func WithDecoder(dec Decoder) ClientConfigurable {
@@ -59,3 +160,18 @@ func WithDecoder(dec Decoder) ClientConfigurable {
 		return nil
 	}
 }
+
+// WithTransferLimits rejects CreateTransfer calls whose amount is outside of min and max before they're sent to Moov.
+// Both amounts must be in the same currency, and transfers in any other currency are rejected. There's no value meaning
+// "unlimited", pass math.MaxInt64 as the max value to leave the upper bound open.
+func WithTransferLimits(min, max Amount) ClientConfigurable {
+	return func(c *Client) error {
+		limits := &transferLimits{min: min, max: max}
+		if err := limits.validate(); err != nil {
+			return err
+		}
+
+		c.transferLimits = limits
+		return nil
+	}
+}
@@ -1,19 +1,24 @@
 package moov_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/moovfinancial/moov-go/pkg/moov"
 	"github.com/stretchr/testify/require"
 
 	"github.com/joho/godotenv"
+	"go.uber.org/goleak"
 )
 
 func BgCtx() context.Context {
@@ -43,6 +48,35 @@ func NewTestClient(t testing.TB, c ...moov.ClientConfigurable) *moov.Client {
 	return mc
 }
 
+// NewMockClient returns a client pointed at a local test server running the handler so calls can be
+// exercised without live credentials.
+func NewMockClient(t testing.TB, handler http.Handler, c ...moov.ClientConfigurable) *moov.Client {
+	srv := httptest.NewTLSServer(handler)
+	t.Cleanup(srv.Close)
+
+	c = append([]moov.ClientConfigurable{
+		moov.WithCredentials(moov.Credentials{
+			PublicKey: "public-key",
+			SecretKey: "secret-key",
+			Host:      srv.Listener.Addr().String(),
+		}),
+		moov.WithHttpClient(srv.Client()),
+		moov.WithDecoder(strictDecoder),
+	}, c...)
+
+	mc, err := moov.NewClient(c...)
+	require.NoError(t, err)
+
+	return mc
+}
+
+// writeJson is a small helper for mock handlers to respond with a JSON body.
+func writeJson(t testing.TB, w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	require.NoError(t, json.NewEncoder(w).Encode(body))
+}
+
 func strictDecoder(r io.Reader, contentType string, item any) error {
 	if strings.Contains(contentType, "application/json") {
 		dec := json.NewDecoder(r)
@@ -63,3 +97,165 @@ func Test_Client_InvalidCredentials(t *testing.T) {
 	_, err := moov.NewClient(moov.WithCredentials(moov.Credentials{}))
 	require.Equal(t, moov.ErrCredentialsNotSet, err)
 }
+
+func Test_Client_Close(t *testing.T) {
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	mc, err := moov.NewClient(
+		moov.WithCredentials(moov.Credentials{PublicKey: "public-key", SecretKey: "secret-key", Host: srv.Listener.Addr().String()}),
+		moov.WithHttpClient(srv.Client()),
+	)
+	require.NoError(t, err)
+
+	inflight := make(chan error, 1)
+	go func() {
+		inflight <- mc.Ping(BgCtx())
+	}()
+	<-started
+
+	wg := sync.WaitGroup{}
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, mc.Close())
+		}()
+	}
+	wg.Wait()
+
+	require.ErrorIs(t, <-inflight, context.Canceled)
+	require.ErrorIs(t, mc.Ping(BgCtx()), moov.ErrClientClosed)
+	require.NoError(t, mc.Close())
+}
+
+func Test_Client_ValidateCredentials(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/ping", r.URL.Path)
+
+		public, secret, _ := r.BasicAuth()
+		if public != "public-key" || secret != "secret-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	newClient := func(secret string) (*moov.Client, error) {
+		return moov.NewClient(
+			moov.WithCredentials(moov.Credentials{PublicKey: "public-key", SecretKey: secret, Host: srv.Listener.Addr().String()}),
+			moov.WithHttpClient(srv.Client()),
+			moov.WithValidateCredentials(),
+		)
+	}
+
+	mc, err := newClient("secret-key")
+	require.NoError(t, err)
+	require.NotNil(t, mc)
+
+	mc, err = newClient("wrong-secret-key")
+	require.Nil(t, mc)
+	require.Equal(t, moov.StatusUnauthenticated, moov.ErrorAsCallResponse(err).Status())
+}
+
+func Test_Client_HTTPTrace(t *testing.T) {
+	trace := &bytes.Buffer{}
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]any{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, "123456789", body["account"].(map[string]any)["accountNumber"])
+
+		writeJson(t, w, http.StatusOK, moov.BankAccount{BankAccountID: "bank-account-id", RoutingNumber: "273976369"})
+	}), moov.WithHTTPTrace(trace))
+
+	bankAccount, err := mc.CreateBankAccount(BgCtx(), "account-id", moov.WithBankAccount(moov.BankAccountRequest{
+		RoutingNumber: "273976369",
+		AccountNumber: "123456789",
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "bank-account-id", bankAccount.BankAccountID)
+
+	dump := trace.String()
+	require.Contains(t, dump, "POST /accounts/account-id/bank-accounts")
+	require.Contains(t, dump, "Authorization: [REDACTED]")
+	require.NotContains(t, dump, "Basic ")
+	require.Contains(t, dump, `"accountNumber":"[REDACTED]"`)
+	require.NotContains(t, dump, "123456789")
+	require.Contains(t, dump, "HTTP/1.1 200 OK")
+	require.Contains(t, dump, "bank-account-id")
+}
+
+func Test_Client_HTTPTrace_Multipart(t *testing.T) {
+	trace := &bytes.Buffer{}
+	var uploaded []string
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Moving the endpoint makes the client send the body a second time
+		if !strings.HasSuffix(r.URL.Path, "/moved") {
+			http.Redirect(w, r, r.URL.Path+"/moved", http.StatusTemporaryRedirect)
+			return
+		}
+
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			t.Errorf("reading uploaded file: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		contents, _ := io.ReadAll(file)
+		uploaded = append(uploaded, string(contents))
+
+		writeJson(t, w, http.StatusOK, moov.DisputeEvidenceUpload{EvidenceID: "evidence-id"})
+	}), moov.WithHTTPTrace(trace))
+
+	_, err := mc.UploadEvidenceFile(BgCtx(), "account-id", "dispute-id", moov.EvidenceType_Receipt, "receipt.pdf", strings.NewReader("secret contents"), "application/pdf")
+	require.NoError(t, err)
+	require.Equal(t, []string{"secret contents"}, uploaded)
+
+	dump := trace.String()
+	require.NotContains(t, dump, "secret contents")
+	require.Contains(t, dump, "file (receipt.pdf, application/pdf): 15 bytes")
+}
+
+func Test_Client_CorrelationID(t *testing.T) {
+	var header string
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get(moov.CorrelationIDHeader)
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	ctx := moov.ContextWithCorrelationID(BgCtx(), "request-1234")
+	require.NoError(t, mc.Ping(ctx))
+	require.Equal(t, "request-1234", header)
+
+	require.NoError(t, mc.Ping(BgCtx()))
+	require.NotEmpty(t, header)
+	require.NotEqual(t, "request-1234", header)
+}
+
+func Test_Client_WithBasePath(t *testing.T) {
+	for _, prefix := range []string{"/moov", "moov/", "/moov/"} {
+		t.Run(prefix, func(t *testing.T) {
+			var path string
+			mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				path = r.URL.Path
+				writeJson(t, w, http.StatusOK, []moov.Transfer{})
+			}), moov.WithBasePath(prefix))
+
+			_, err := mc.ListTransfers(BgCtx(), "account-id")
+			require.NoError(t, err)
+			require.Equal(t, "/moov/accounts/account-id/transfers", path)
+		})
+	}
+}
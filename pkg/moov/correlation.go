@@ -0,0 +1,31 @@
+package moov
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// CorrelationIDHeader is the header every request carries its correlation ID in.
+const CorrelationIDHeader = "X-Correlation-ID"
+
+type correlationIDKey struct{}
+
+// ContextWithCorrelationID tags every call made with the returned context with the correlation ID, so calls to Moov can
+// be tied back to the request in the caller's service that made them. Calls without one get a random ID.
+func ContextWithCorrelationID(ctx context.Context, correlationID string) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, correlationID)
+}
+
+// CorrelationIDFromContext returns the correlation ID set by ContextWithCorrelationID.
+func CorrelationIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(correlationIDKey{}).(string)
+	return id, ok && id != ""
+}
+
+func correlationID(ctx context.Context) string {
+	if id, ok := CorrelationIDFromContext(ctx); ok {
+		return id
+	}
+	return uuid.NewString()
+}
@@ -8,6 +8,9 @@ const ENV_MOOV_HOST = "MOOV_HOST"
 const ENV_MOOV_PUBLIC_KEY = "MOOV_PUBLIC_KEY"
 const ENV_MOOV_SECRET_KEY = "MOOV_SECRET_KEY" // #nosec G101
 
+// DefaultHost is the production API used when no host is configured.
+const DefaultHost = "api.moov.io"
+
 func CredentialsDefault() Credentials {
 	return Credentials{}
 }
@@ -19,7 +22,7 @@ func CredentialsFromEnv() Credentials {
 
 	creds.Host = os.Getenv(ENV_MOOV_HOST)
 	if creds.Host == "" {
-		creds.Host = "api.moov.io"
+		creds.Host = DefaultHost
 	}
 
 	return creds
@@ -28,7 +31,17 @@ func CredentialsFromEnv() Credentials {
 type Credentials struct {
 	PublicKey string `yaml:"public_key,omitempty"`
 	SecretKey string `yaml:"secret_key,omitempty"`
-	Host      string `yaml:"host,omitempty"`
+
+	// Host all calls, including fetching OAuth2 tokens, are made against. Set this to point at staging environments or
+	// proxies. Defaults to DefaultHost when empty.
+	Host string `yaml:"host,omitempty"`
+}
+
+func (c *Credentials) host() string {
+	if c.Host == "" {
+		return DefaultHost
+	}
+	return c.Host
 }
 
 func (c *Credentials) Validate() error {
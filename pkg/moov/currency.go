@@ -0,0 +1,123 @@
+package moov
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Active ISO 4217 currency codes
+var currencyCodes = strings.Fields(`
+	AED AFN ALL AMD ANG AOA ARS AUD AWG AZN BAM BBD BDT BGN BHD BIF BMD BND BOB BRL BSD BTN BWP BYN BZD CAD CDF CHF
+	CLP CNY COP CRC CUP CVE CZK DJF DKK DOP DZD EGP ERN ETB EUR FJD FKP GBP GEL GHS GIP GMD GNF GTQ GYD HKD HNL HTG
+	HUF IDR ILS INR IQD IRR ISK JMD JOD JPY KES KGS KHR KMF KPW KRW KWD KYD KZT LAK LBP LKR LRD LSL LYD MAD MDL MGA
+	MKD MMK MNT MOP MRU MUR MVR MWK MXN MYR MZN NAD NGN NIO NOK NPR NZD OMR PAB PEN PGK PHP PKR PLN PYG QAR RON RSD
+	RUB RWF SAR SBD SCR SDG SEK SGD SHP SLE SOS SRD SSP STN SVC SYP SZL THB TJS TMT TND TOP TRY TTD TWD TZS UAH UGX
+	USD UYU UZS VED VES VND VUV WST XAF XCD XOF XPF YER ZAR ZMW ZWL
+`)
+
+// Currencies Moov moves money in. Moov doesn't convert between currencies, so a transfer's source and destination are
+// always in the same one.
+var supportedCurrencies = []Currency{"USD"}
+
+// WithDefaultCurrency sets the currency of transfers created without one, such as with CreateTransfer, ChargeCard, or
+// Payout, so single-currency integrations can leave it off. The currency must be an ISO 4217 code Moov supports.
+func WithDefaultCurrency(currency string) ClientConfigurable {
+	return func(c *Client) error {
+		currency = strings.ToUpper(strings.TrimSpace(currency))
+		if !slices.Contains(currencyCodes, currency) {
+			return fmt.Errorf("%w: %q is not an ISO 4217 currency code", ErrInvalidAmount, currency)
+		}
+		if !Currency(currency).Supported() {
+			return fmt.Errorf("%w: %s", ErrCurrencyNotSupported, currency)
+		}
+
+		c.defaultCurrency = currency
+		return nil
+	}
+}
+
+// withDefaultCurrency fills in the client's default currency if the amount doesn't have one
+func (c Client) withDefaultCurrency(amount Amount) Amount {
+	if amount.Currency == "" {
+		amount.Currency = c.defaultCurrency
+	}
+	return amount
+}
+
+// Currency is an ISO 4217 currency code
+type Currency string
+
+// Supported reports if Moov moves money in the currency.
+func (c Currency) Supported() bool {
+	return slices.Contains(supportedCurrencies, Currency(strings.ToUpper(string(c))))
+}
+
+// Currencies whose minor unit isn't a hundredth of the major unit
+var currencyExponents = map[Currency]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0, "PYG": 0, "RWF": 0, "UGX": 0,
+	"VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+}
+
+// Exponent returns the number of decimal places between the currency's major and minor units, such as 2 for USD's
+// cents, 0 for JPY which has no minor unit, and 3 for BHD's fils. Amount values are in the minor unit.
+func (c Currency) Exponent() int {
+	if exp, ok := currencyExponents[Currency(strings.ToUpper(string(c)))]; ok {
+		return exp
+	}
+	return 2
+}
+
+// String renders the amount in its currency's major unit followed by the currency, such as "12.04 USD", "1204 JPY", or
+// "1.204 BHD".
+func (a Amount) String() string {
+	exp := Currency(a.Currency).Exponent()
+
+	sign := ""
+	value := a.Value
+	if value < 0 {
+		sign, value = "-", -value
+	}
+
+	digits := strconv.FormatInt(value, 10)
+	if exp > 0 {
+		// Pad so there's at least one digit before the decimal point
+		if len(digits) <= exp {
+			digits = strings.Repeat("0", exp-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-exp] + "." + digits[len(digits)-exp:]
+	}
+
+	return strings.TrimSpace(sign + digits + " " + strings.ToUpper(a.Currency))
+}
+
+// ParseAmount parses a decimal amount in the currency's major unit, such as "12.04" USD, into an Amount in minor units.
+// More decimal places than the currency has are rejected rather than rounded, so "1.5" JPY is an error.
+func ParseAmount(s string, currency string) (Amount, error) {
+	currency = strings.ToUpper(currency)
+	exp := Currency(currency).Exponent()
+
+	whole, frac, _ := strings.Cut(strings.TrimSpace(s), ".")
+	if len(frac) > exp {
+		return Amount{}, fmt.Errorf("%w: %q has more than %d decimal places for %s", ErrInvalidAmount, s, exp, currency)
+	}
+
+	negative := strings.HasPrefix(whole, "-")
+	digits := strings.TrimPrefix(whole, "-") + frac
+	if digits == "" || strings.IndexFunc(digits, func(r rune) bool { return r < '0' || r > '9' }) >= 0 {
+		return Amount{}, fmt.Errorf("%w: %q is not a decimal number", ErrInvalidAmount, s)
+	}
+	digits += strings.Repeat("0", exp-len(frac))
+
+	value, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return Amount{}, fmt.Errorf("%w: %q: %w", ErrInvalidAmount, s, err)
+	}
+	if negative {
+		value = -value
+	}
+
+	return Amount{Currency: currency, Value: value}, nil
+}
@@ -0,0 +1,79 @@
+package moov_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moovfinancial/moov-go/pkg/moov"
+)
+
+func TestCurrency_Exponent(t *testing.T) {
+	require.Equal(t, 2, moov.Currency("USD").Exponent())
+	require.Equal(t, 0, moov.Currency("JPY").Exponent())
+	require.Equal(t, 3, moov.Currency("BHD").Exponent())
+	require.Equal(t, 3, moov.Currency("bhd").Exponent())
+}
+
+func TestCurrency_Supported(t *testing.T) {
+	require.True(t, moov.Currency("USD").Supported())
+	require.True(t, moov.Currency("usd").Supported())
+	require.False(t, moov.Currency("EUR").Supported())
+}
+
+func TestAmount_String(t *testing.T) {
+	cases := []struct {
+		amount moov.Amount
+		want   string
+	}{
+		{moov.Amount{Currency: "USD", Value: 1204}, "12.04 USD"},
+		{moov.Amount{Currency: "USD", Value: 5}, "0.05 USD"},
+		{moov.Amount{Currency: "USD", Value: -1204}, "-12.04 USD"},
+		{moov.Amount{Currency: "JPY", Value: 1204}, "1204 JPY"},
+		{moov.Amount{Currency: "BHD", Value: 1204}, "1.204 BHD"},
+		{moov.Amount{Currency: "BHD", Value: 4}, "0.004 BHD"},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.want, c.amount.String())
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	cases := []struct {
+		value    string
+		currency string
+		want     int64
+	}{
+		{"12.04", "USD", 1204},
+		{"12", "USD", 1200},
+		{"12.5", "USD", 1250},
+		{"-0.05", "USD", -5},
+		{"1204", "JPY", 1204},
+		{"1.204", "BHD", 1204},
+		{"1.2", "BHD", 1200},
+	}
+	for _, c := range cases {
+		amount, err := moov.ParseAmount(c.value, c.currency)
+		require.NoError(t, err)
+		require.Equal(t, moov.Amount{Currency: c.currency, Value: c.want}, amount)
+
+		// Rendering parses back to the same amount
+		value, _, _ := strings.Cut(amount.String(), " ")
+		roundTrip, err := moov.ParseAmount(value, c.currency)
+		require.NoError(t, err)
+		require.Equal(t, amount, roundTrip)
+	}
+
+	for _, c := range []struct{ value, currency string }{
+		{"1.5", "JPY"},
+		{"12.045", "USD"},
+		{"1.2345", "BHD"},
+		{"", "USD"},
+		{"12,04", "USD"},
+		{"1.-5", "USD"},
+	} {
+		_, err := moov.ParseAmount(c.value, c.currency)
+		require.ErrorIs(t, err, moov.ErrInvalidAmount, c.value)
+	}
+}
@@ -0,0 +1,92 @@
+package moov
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Deprecation describes an endpoint Moov has marked as deprecated with the Deprecation or Sunset response headers.
+type Deprecation struct {
+	Method string
+	// Path of the endpoint with placeholders in place of IDs, such as /accounts/%s/transfers
+	Endpoint string
+	// When the endpoint was or will be deprecated, zero if Moov didn't say.
+	DeprecatedOn time.Time
+	// When the endpoint will stop working, zero if Moov didn't say.
+	Sunset time.Time
+}
+
+// WithDeprecationObserver calls observer the first time each endpoint responds as deprecated, in place of the default
+// warning logged with slog. The observer must not be nil.
+func WithDeprecationObserver(observer func(Deprecation)) ClientConfigurable {
+	return func(c *Client) error {
+		if observer == nil {
+			return errors.New("deprecation observer must not be nil")
+		}
+		c.deprecations = &deprecations{observer: observer}
+		return nil
+	}
+}
+
+func logDeprecation(d Deprecation) {
+	args := []any{"method", d.Method, "endpoint", d.Endpoint}
+	if !d.DeprecatedOn.IsZero() {
+		args = append(args, "deprecated_on", d.DeprecatedOn)
+	}
+	if !d.Sunset.IsZero() {
+		args = append(args, "sunset", d.Sunset)
+	}
+	slog.Warn("moov endpoint is deprecated", args...)
+}
+
+type deprecations struct {
+	observer func(Deprecation)
+	seen     sync.Map
+}
+
+// check reports the call's endpoint to the observer if the response marks it deprecated and it hasn't been reported yet
+func (d *deprecations) check(call *callBuilder, header http.Header) {
+	deprecation, ok := parseDeprecation(header)
+	if !ok {
+		return
+	}
+
+	deprecation.Method = call.method
+	deprecation.Endpoint = call.endpoint
+	if _, reported := d.seen.LoadOrStore(deprecation.Method+" "+deprecation.Endpoint, true); reported {
+		return
+	}
+
+	d.observer(deprecation)
+}
+
+// parseDeprecation reads the Deprecation header, either a structured date like @1688169599 (RFC 9745) or the older
+// "true" and HTTP-date forms, along with the Sunset HTTP-date (RFC 8594).
+func parseDeprecation(header http.Header) (Deprecation, bool) {
+	var d Deprecation
+
+	deprecated := strings.TrimSpace(header.Get("Deprecation"))
+	sunset := strings.TrimSpace(header.Get("Sunset"))
+	if (deprecated == "" || deprecated == "false") && sunset == "" {
+		return d, false
+	}
+
+	if unix, ok := strings.CutPrefix(deprecated, "@"); ok {
+		if secs, err := strconv.ParseInt(unix, 10, 64); err == nil {
+			d.DeprecatedOn = time.Unix(secs, 0).UTC()
+		}
+	} else if t, err := http.ParseTime(deprecated); err == nil {
+		d.DeprecatedOn = t
+	}
+
+	if t, err := http.ParseTime(sunset); err == nil {
+		d.Sunset = t
+	}
+
+	return d, true
+}
@@ -0,0 +1,38 @@
+package moov_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/moovfinancial/moov-go/pkg/moov"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithDeprecationObserver(t *testing.T) {
+	sunset := time.Date(2026, time.December, 31, 23, 59, 59, 0, time.UTC)
+
+	var observed []moov.Deprecation
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Deprecation", "@1688169599")
+		w.Header().Set("Sunset", sunset.Format(http.TimeFormat))
+		w.WriteHeader(http.StatusOK)
+	}), moov.WithDeprecationObserver(func(d moov.Deprecation) {
+		observed = append(observed, d)
+	}))
+
+	require.NoError(t, mc.Ping(BgCtx()))
+	require.NoError(t, mc.Ping(BgCtx()))
+
+	// reported once per endpoint
+	require.Len(t, observed, 1)
+	require.Equal(t, http.MethodGet, observed[0].Method)
+	require.Equal(t, "/ping", observed[0].Endpoint)
+	require.Equal(t, time.Unix(1688169599, 0).UTC(), observed[0].DeprecatedOn)
+	require.True(t, sunset.Equal(observed[0].Sunset))
+}
+
+func TestWithDeprecationObserver_Nil(t *testing.T) {
+	_, err := moov.NewClient(moov.WithCredentials(moov.Credentials{PublicKey: "public-key", SecretKey: "secret-key"}), moov.WithDeprecationObserver(nil))
+	require.ErrorContains(t, err, "deprecation observer must not be nil")
+}
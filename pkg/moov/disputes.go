@@ -2,6 +2,7 @@ package moov
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -20,6 +21,21 @@ type Dispute struct {
 	Transfer                 Transfer      `json:"transfer,omitempty"`
 }
 
+// ResponseDeadline returns when evidence has to be submitted by. ok is false when Moov hasn't set a deadline.
+func (d Dispute) ResponseDeadline() (respondBy time.Time, ok bool) {
+	return d.RespondBy, !d.RespondBy.IsZero()
+}
+
+// TimeRemaining is how long is left to respond to the dispute. It's zero once the deadline has passed or if there's no
+// deadline.
+func (d Dispute) TimeRemaining() time.Duration {
+	respondBy, ok := d.ResponseDeadline()
+	if !ok {
+		return 0
+	}
+	return max(time.Until(respondBy), 0)
+}
+
 type DisputeStatus string
 
 const (
@@ -124,6 +140,14 @@ func WithDisputeCardHolderAccountID(id string) DisputeListFilter {
 	})
 }
 
+// WithDisputeTransferID only lists disputes raised against the transfer.
+func WithDisputeTransferID(transferID string) DisputeListFilter {
+	return callBuilderFn(func(call *callBuilder) error {
+		call.params["transferIDs"] = transferID
+		return nil
+	})
+}
+
 func WithDisputeStartDate(t time.Time) DisputeListFilter {
 	return callBuilderFn(func(call *callBuilder) error {
 		call.params["startDateTime"] = t.Format(time.RFC3339)
@@ -145,6 +169,15 @@ func WithDisputeOrderBy(orderBy string) DisputeListFilter {
 	})
 }
 
+// Fields disputes can be ordered by
+var disputeOrderByFields = []string{"createdOn", "respondBy", "amount"}
+
+// WithDisputeOrderByField sorts the disputes by one of createdOn, respondBy, or amount. Disputes are ordered by
+// createdOn descending by default so paging through them is stable.
+func WithDisputeOrderByField(field string, desc bool) DisputeListFilter {
+	return orderBy(field, desc, disputeOrderByFields...)
+}
+
 type EvidenceType string
 
 const (
@@ -161,7 +194,7 @@ const (
 // ListDisputes lists of Disputes that are associated with a Moov account
 // https://docs.moov.io/api/money-movement/disputes/list/
 func (c Client) ListDisputes(ctx context.Context, accountID string, filters ...DisputeListFilter) ([]Dispute, error) {
-	args := prependArgs(filters, AcceptJson())
+	args := prependArgs(filters, AcceptJson(), WithDisputeOrderByField("createdOn", true))
 	resp, err := c.CallHttp(ctx, Endpoint(http.MethodGet, pathDisputes, accountID), args...)
 	if err != nil {
 		return nil, err
@@ -170,6 +203,37 @@ func (c Client) ListDisputes(ctx context.Context, accountID string, filters ...D
 	return CompletedListOrError[Dispute](resp)
 }
 
+// DisputesDueWithin lists the disputes still needing a response whose deadline is within d from now, soonest first.
+func (c Client) DisputesDueWithin(ctx context.Context, accountID string, d time.Duration) ([]Dispute, error) {
+	now := time.Now()
+	end := now.Add(d)
+
+	return listAllPages(func(skip, count int) ([]Dispute, error) {
+		return c.ListDisputes(ctx, accountID,
+			WithDisputeStatus(string(DisputeStatus_ResponseNeeded)),
+			WithDisputeResponseStartDate(now),
+			WithDisputeResponseEndDate(end),
+			WithDisputeOrderByField("respondBy", false),
+			WithDisputeCount(count),
+			WithDisputeSkip(skip))
+	}, func(dispute Dispute) bool {
+		respondBy, ok := dispute.ResponseDeadline()
+		return ok && !respondBy.Before(now) && !respondBy.After(end)
+	})
+}
+
+// GetTransferDisputes lists every dispute raised against the transfer, such as when its card payment shows as disputed.
+func (c Client) GetTransferDisputes(ctx context.Context, accountID string, transferID string) ([]Dispute, error) {
+	return listAllPages(func(skip, count int) ([]Dispute, error) {
+		return c.ListDisputes(ctx, accountID,
+			WithDisputeTransferID(transferID),
+			WithDisputeCount(count),
+			WithDisputeSkip(skip))
+	}, func(dispute Dispute) bool {
+		return dispute.Transfer.TransferID == transferID
+	})
+}
+
 // GetDispute retrieves a dispute for the given dispute id
 // https://docs.moov.io/api/money-movement/disputes/get/
 func (c Client) GetDispute(ctx context.Context, accountID string, disputeID string) (*Dispute, error) {
@@ -204,14 +268,15 @@ func (c Client) UploadDisputeEvidence(ctx context.Context, accountID string, dis
 	return CompletedObjectOrError[DisputeEvidence](resp)
 }
 
-// DeleteDisputeEvidence deletes a piece of dispute evidence for the given dispute and evidence id
+// DeleteDisputeEvidence deletes a piece of dispute evidence for the given dispute and evidence id. Evidence can only be
+// deleted before it's submitted, afterwards ErrDisputeEvidenceSubmitted is returned.
 // https://docs.moov.io/api/money-movement/disputes/delete
 func (c Client) DeleteDisputeEvidence(ctx context.Context, accountID string, disputeID, evidenceID string) error {
-	resp, err := c.CallHttp(ctx, Endpoint(http.MethodDelete, pathDisputeEvidence, accountID, disputeID, evidenceID), AcceptJson())
-	if err != nil {
-		return err
+	err := c.delete(ctx, Endpoint(http.MethodDelete, pathDisputeEvidence, accountID, disputeID, evidenceID))
+	if resp := ErrorAsCallResponse(err); resp != nil && resp.Status() == StatusStateConflict {
+		return errors.Join(ErrDisputeEvidenceSubmitted, err)
 	}
-	return CompletedNilOrError(resp)
+	return err
 }
 
 // UploadEvidenceFile uploads a new evidence file for the given dispute id
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/moovfinancial/moov-go/pkg/moov"
@@ -193,3 +195,79 @@ func Test_GetDisputeEvidence_DisputeNotFound(t *testing.T) {
 
 	require.Equal(t, moov.StatusNotFound, httpErr.Status())
 }
+
+func Test_DeleteDisputeEvidence(t *testing.T) {
+	submitted := false
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodDelete, r.Method)
+		require.Equal(t, "/accounts/account-id/disputes/dispute-id/evidence/evidence-id", r.URL.Path)
+
+		if submitted {
+			writeJson(t, w, http.StatusConflict, map[string]string{"error": "evidence already submitted"})
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	t.Run("before submission", func(t *testing.T) {
+		err := mc.DeleteDisputeEvidence(context.Background(), "account-id", "dispute-id", "evidence-id")
+		require.NoError(t, err)
+	})
+
+	t.Run("after submission", func(t *testing.T) {
+		submitted = true
+
+		err := mc.DeleteDisputeEvidence(context.Background(), "account-id", "dispute-id", "evidence-id")
+		require.ErrorIs(t, err, moov.ErrDisputeEvidenceSubmitted)
+		require.Equal(t, moov.StatusStateConflict, moov.ErrorAsCallResponse(err).Status())
+	})
+}
+
+func Test_DisputesDueWithin(t *testing.T) {
+	now := time.Now()
+
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/accounts/account-id/disputes", r.URL.Path)
+		require.Equal(t, "response-needed", r.URL.Query().Get("status"))
+		require.Equal(t, "respondBy:asc", r.URL.Query().Get("orderBy"))
+		require.NotEmpty(t, r.URL.Query().Get("respondStartDateTime"))
+		require.NotEmpty(t, r.URL.Query().Get("respondEndDateTime"))
+
+		writeJson(t, w, http.StatusOK, []moov.Dispute{
+			{DisputeID: "overdue", RespondBy: now.Add(-time.Hour)},
+			{DisputeID: "tomorrow", RespondBy: now.Add(24 * time.Hour)},
+			{DisputeID: "next-week", RespondBy: now.Add(7 * 24 * time.Hour)},
+			{DisputeID: "no-deadline"},
+		})
+	}))
+
+	due, err := mc.DisputesDueWithin(BgCtx(), "account-id", 48*time.Hour)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	require.Equal(t, "tomorrow", due[0].DisputeID)
+
+	require.InDelta(t, 24*time.Hour, due[0].TimeRemaining(), float64(time.Minute))
+	require.Zero(t, moov.Dispute{RespondBy: now.Add(-time.Hour)}.TimeRemaining())
+
+	_, ok := moov.Dispute{}.ResponseDeadline()
+	require.False(t, ok)
+}
+
+func Test_GetTransferDisputes(t *testing.T) {
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/accounts/account-id/disputes", r.URL.Path)
+		require.Equal(t, "transfer-id", r.URL.Query().Get("transferIDs"))
+
+		writeJson(t, w, http.StatusOK, []moov.Dispute{
+			{DisputeID: "inquiry", Phase: moov.DisputePhase_Inquiry, Transfer: moov.Transfer{TransferID: "transfer-id"}},
+			{DisputeID: "chargeback", Phase: moov.DisputePhase_Chargeback, Transfer: moov.Transfer{TransferID: "transfer-id"}},
+			{DisputeID: "other", Transfer: moov.Transfer{TransferID: "other-transfer-id"}},
+		})
+	}))
+
+	disputes, err := mc.GetTransferDisputes(BgCtx(), "account-id", "transfer-id")
+	require.NoError(t, err)
+	require.Len(t, disputes, 2)
+	require.Equal(t, "inquiry", disputes[0].DisputeID)
+	require.Equal(t, "chargeback", disputes[1].DisputeID)
+}
@@ -0,0 +1,106 @@
+package moov
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// DryRunHeader is set to "true" on the responses of calls WithDryRun skipped sending, read it through ResponseHeaders.
+const DryRunHeader = "X-Moov-Dry-Run"
+
+// DryRunRequest is a call WithDryRun skipped sending to Moov.
+type DryRunRequest struct {
+	// Name of the operation the call was for, see WithOperationName
+	Operation string
+	Method    string
+	Path      string
+	Query     url.Values
+	// Body of the call with sensitive fields redacted the same as WithHTTPTrace. Multipart bodies, such as uploaded
+	// files, are summarized by the name and size of each part.
+	Body []byte
+}
+
+// DryRunOption changes what WithDryRun skips and how skipped calls are reported.
+type DryRunOption func(d *dryRun)
+type dryRun struct {
+	reads    bool
+	observer func(DryRunRequest)
+}
+
+// WithDryRunReads skips sending reads as well, returning empty results for them.
+func WithDryRunReads() DryRunOption {
+	return func(d *dryRun) {
+		d.reads = true
+	}
+}
+
+// WithDryRunObserver calls observer with each call that's skipped, in place of the default message logged with slog.
+func WithDryRunObserver(observer func(DryRunRequest)) DryRunOption {
+	return func(d *dryRun) {
+		d.observer = observer
+	}
+}
+
+// WithDryRun logs the calls that would create, change, or delete resources instead of sending them, so an integration's
+// wiring can be checked in staging without creating anything. Skipped calls succeed with an empty result: the returned
+// resources are zero values without IDs and their responses have DryRunHeader set. Reads and fetching access tokens are
+// still sent unless WithDryRunReads is given, including reads Moov takes as a POST such as TransferOptions.
+func WithDryRun(opts ...DryRunOption) ClientConfigurable {
+	return func(c *Client) error {
+		c.dryRun = applyOptions(&dryRun{observer: logDryRun}, opts)
+		return nil
+	}
+}
+
+func logDryRun(r DryRunRequest) {
+	slog.Info("moov dry run skipped call", "operation", r.Operation, "method", r.Method, "path", r.Path, "query", r.Query.Encode(), "body", string(r.Body))
+}
+
+// skips reports if the call shouldn't be sent
+func (d *dryRun) skips(call *callBuilder) bool {
+	if strings.HasPrefix(call.path, "/oauth2/") {
+		return false
+	}
+
+	switch {
+	case call.readOnly, call.method == http.MethodGet, call.method == http.MethodHead:
+		return d.reads
+	default:
+		return true
+	}
+}
+
+// send reports the call to the observer and returns an empty successful response in place of sending it.
+func (d *dryRun) send(_ context.Context, call *callBuilder) (*httpCallResponse, error) {
+	req := DryRunRequest{
+		Operation: call.operation(),
+		Method:    call.method,
+		Path:      call.path,
+		Query:     url.Values{},
+	}
+	for k, v := range call.params {
+		req.Query.Set(k, v)
+	}
+
+	if call.body != nil {
+		body, err := io.ReadAll(call.body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body = summarizedBody(call.headers["Content-Type"], body)
+	}
+
+	d.observer(req)
+
+	return &httpCallResponse{
+		resp: &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{DryRunHeader: []string{"true"}},
+		},
+		dryRun: true,
+	}, nil
+}
@@ -0,0 +1,112 @@
+package moov_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moovfinancial/moov-go/pkg/moov"
+)
+
+func TestWithDryRun(t *testing.T) {
+	var requests []string
+	var skipped []moov.DryRunRequest
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeJson(t, w, http.StatusOK, moov.Account{AccountID: "account-id"})
+	}), moov.WithDryRun(moov.WithDryRunObserver(func(r moov.DryRunRequest) {
+		skipped = append(skipped, r)
+	})))
+
+	created, started, err := mc.CreateAccount(BgCtx(), moov.CreateAccount{
+		Type: moov.AccountType_Individual,
+		Profile: moov.CreateProfile{
+			Individual: &moov.CreateIndividualProfile{
+				Name:  moov.Name{FirstName: "Jules", LastName: "Jackson"},
+				Email: "jules@example.com",
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Nil(t, started)
+	require.NotNil(t, created)
+	require.Empty(t, created.AccountID)
+
+	// Reads are still sent
+	account, err := mc.GetAccount(BgCtx(), "account-id")
+	require.NoError(t, err)
+	require.Equal(t, "account-id", account.AccountID)
+	require.Equal(t, []string{"GET /accounts/account-id"}, requests)
+
+	require.NoError(t, mc.DisconnectAccount(BgCtx(), "account-id"))
+	require.Equal(t, []string{"GET /accounts/account-id"}, requests)
+
+	require.Len(t, skipped, 2)
+	require.Equal(t, http.MethodDelete, skipped[1].Method)
+	require.Equal(t, "/accounts/account-id", skipped[1].Path)
+	require.Equal(t, http.MethodPost, skipped[0].Method)
+	require.Equal(t, "/accounts", skipped[0].Path)
+	require.Equal(t, "POST /accounts", skipped[0].Operation)
+
+	var body moov.CreateAccount
+	require.NoError(t, json.Unmarshal(skipped[0].Body, &body))
+	require.Equal(t, "Jules", body.Profile.Individual.Name.FirstName)
+}
+
+func TestWithDryRunReads(t *testing.T) {
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		w.WriteHeader(http.StatusNotFound)
+	}), moov.WithDryRun(moov.WithDryRunReads(), moov.WithDryRunObserver(func(moov.DryRunRequest) {})))
+
+	resp, err := mc.CallHttp(BgCtx(), moov.Endpoint(http.MethodGet, "/accounts/%s", "account-id"), moov.AcceptJson())
+	require.NoError(t, err)
+	require.Equal(t, moov.StatusCompleted, resp.Status())
+	headers, ok := resp.(moov.ResponseHeaders)
+	require.True(t, ok)
+	require.Equal(t, "true", headers.Header(moov.DryRunHeader))
+
+	accounts, err := mc.ListAccounts(BgCtx())
+	require.NoError(t, err)
+	require.Empty(t, accounts)
+}
+
+func TestWithDryRun_PostReads(t *testing.T) {
+	var requests []string
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		writeJson(t, w, http.StatusOK, moov.TransferOptions{})
+	}), moov.WithDryRun(moov.WithDryRunObserver(func(moov.DryRunRequest) {})))
+
+	_, err := mc.TransferOptions(BgCtx(), moov.CreateTransferOptions{
+		Amount: moov.Amount{Currency: "USD", Value: 100},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"POST /transfer-options"}, requests)
+}
+
+func TestWithDryRun_MultipartBody(t *testing.T) {
+	var skipped []moov.DryRunRequest
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		w.WriteHeader(http.StatusNotFound)
+	}), moov.WithDryRun(moov.WithDryRunObserver(func(r moov.DryRunRequest) {
+		skipped = append(skipped, r)
+	})))
+
+	_, err := mc.UploadEvidenceFile(BgCtx(), "account-id", "dispute-id", moov.EvidenceType_Receipt, "receipt.pdf", strings.NewReader("secret contents"), "application/pdf")
+	require.NoError(t, err)
+
+	require.Len(t, skipped, 1)
+	body := string(skipped[0].Body)
+	require.NotContains(t, body, "secret contents")
+	require.Contains(t, body, "file (receipt.pdf, application/pdf): 15 bytes")
+}
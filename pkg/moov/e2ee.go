@@ -12,7 +12,7 @@ type EndToEndToken struct {
 }
 
 func (c Client) GenerateEndToEndPublicKey(ctx context.Context) (*jose.JSONWebKey, error) {
-	resp, err := c.CallHttp(ctx, Endpoint(http.MethodPost, pathEndToEndPublicKey))
+	resp, err := c.CallHttp(ctx, Endpoint(http.MethodPost, pathEndToEndPublicKey), readOnly())
 	if err != nil {
 		return nil, err
 	}
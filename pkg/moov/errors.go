@@ -2,7 +2,9 @@ package moov
 
 import (
 	"errors"
+	"fmt"
 	"strings"
+	"time"
 )
 
 func ErrorAsCallResponse(err error) CallResponse {
@@ -19,6 +21,112 @@ func ErrorAsHttpCallResponse(err error) HttpCallResponse {
 	return nil
 }
 
+// TransportError is returned when a call couldn't get a response from Moov, such as DNS failures, refused connections,
+// or timeouts. Calls that did get a response return an HttpCallResponse instead, so the two can be told apart with
+// errors.As when deciding if a call should be retried or alerted on.
+type TransportError struct {
+	Method string
+	Path   string
+
+	Err error
+}
+
+func (e *TransportError) Error() string {
+	return fmt.Sprintf("unable to reach moov - %s %s: %v", e.Method, e.Path, e.Err)
+}
+
+func (e *TransportError) Unwrap() error {
+	return e.Err
+}
+
+// ResponseTooLargeError is returned when a response body is larger than the client's WithMaxResponseBytes limit.
+type ResponseTooLargeError struct {
+	Method string
+	Path   string
+	Limit  int64
+}
+
+func (e *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("response to %s %s is larger than the %d byte limit", e.Method, e.Path, e.Limit)
+}
+
+// MaintenanceError is returned when Moov responds 503 Service Unavailable because it's down for maintenance, as opposed
+// to a 503 from an outage. RetryAfter is how long Moov asked callers to wait, zero if it didn't say.
+type MaintenanceError struct {
+	Message    string
+	RetryAfter time.Duration
+
+	Err error
+}
+
+func (e *MaintenanceError) Error() string {
+	msg := "moov is down for maintenance"
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	if e.RetryAfter > 0 {
+		msg += fmt.Sprintf(", retry after %s", e.RetryAfter)
+	}
+	return msg
+}
+
+func (e *MaintenanceError) Unwrap() error {
+	return e.Err
+}
+
+// CapabilityPendingError is returned when waiting on a capability ends while Moov is still reviewing it.
+type CapabilityPendingError struct {
+	Capability CapabilityName
+	// Requirements that are due or errored, see Capability.OutstandingRequirements
+	Requirements []RequirementId
+
+	Err error
+}
+
+func (e *CapabilityPendingError) Error() string {
+	return fmt.Sprintf("capability %s is still pending with outstanding requirements %v: %v", e.Capability, e.Requirements, e.Err)
+}
+
+func (e *CapabilityPendingError) Unwrap() error {
+	return e.Err
+}
+
+// IdempotencyConflictError is returned when a create collided with an earlier request using the same idempotency key
+// but the resource that request created couldn't be returned in its place.
+type IdempotencyConflictError struct {
+	// ID of the schedule created by the earlier request, if it's known.
+	ScheduleID string
+
+	Err error
+}
+
+func (e *IdempotencyConflictError) Error() string {
+	if e.ScheduleID != "" {
+		return fmt.Sprintf("idempotency key already used to create schedule %s: %v", e.ScheduleID, e.Err)
+	}
+	return fmt.Sprintf("idempotency key already used: %v", e.Err)
+}
+
+func (e *IdempotencyConflictError) Unwrap() error {
+	return e.Err
+}
+
+// ForeignIDExistsError is returned when creating or updating an account would give it a foreignID another account
+// already has. It matches ErrForeignIDExists with errors.Is.
+type ForeignIDExistsError struct {
+	ForeignID string
+	// ID of the account that already has the foreignID
+	AccountID string
+}
+
+func (e *ForeignIDExistsError) Error() string {
+	return fmt.Sprintf("%v: foreignID %s is used by account %s", ErrForeignIDExists, e.ForeignID, e.AccountID)
+}
+
+func (e *ForeignIDExistsError) Unwrap() error {
+	return ErrForeignIDExists
+}
+
 func errorAsA[A interface{}](err error) *A {
 	t := new(A)
 	if errors.As(err, t) {
@@ -29,11 +137,41 @@ func errorAsA[A interface{}](err error) *A {
 
 var (
 	ErrCredentialsNotSet            = errors.New("api credentials not set")
+	ErrScopeNotAccountScoped        = errors.New("scope is not limited to the account")
+	ErrClientClosed                 = errors.New("client has been closed")
 	ErrAccountNotFound              = errors.New("no account with the specified accountID was found")
+	ErrForeignIDNotFound            = errors.New("no account with the specified foreignID was found")
+	ErrMultipleAccountsFound        = errors.New("more than one account matched")
+	ErrForeignIDExists              = errors.New("an account with the foreignID already exists")
+	ErrBankAccountNotFound          = errors.New("no bank account matched")
+	ErrPaymentMethodNotFound        = errors.New("no payment method matched")
+	ErrNotFound                     = errors.New("resource not found")
 	ErrAlreadyExists                = errors.New("resource already exists")
 	ErrMicroDepositAmountsIncorrect = errors.New("the amounts provided are incorrect or the bank account is in an unexpected state")
+	ErrMicroDepositsAlreadyPending  = errors.New("micro-deposits were already sent and are pending verification")
 	ErrInstantVerificationFailed    = errors.New("attempted verification failed")
 	ErrXIdempotencyKey              = errors.New("attempted to create a transfer using a duplicate X-Idempotency-Key header")
+	ErrTransferNotSettling          = errors.New("transfer won't settle")
+	ErrDisputeEvidenceSubmitted     = errors.New("dispute evidence has already been submitted")
+	ErrPaymentMethodNotEnabled      = errors.New("payment method isn't enabled for the source yet")
+	ErrPlaidTokenRequired           = errors.New("plaid public token is required")
+	ErrPlaidLinkFailed              = errors.New("plaid was unable to link the bank account")
+	ErrAccountNotDisconnectable     = errors.New("account has a nonzero wallet balance or pending transfers")
+	ErrInvalidAmount                = errors.New("invalid amount")
+	ErrAmountOutOfRange             = errors.New("transfer amount is outside of the configured limits")
+	ErrReversalExceedsTransfer      = errors.New("reversal amount is more than the transfer has left to reverse")
+	ErrStatementDescriptorTooLong   = errors.New("statement descriptor is too long for the rail")
+	ErrCurrencyMismatch             = errors.New("amounts are in different currencies")
+	ErrCurrencyNotSupported         = errors.New("currency isn't supported by moov")
+	ErrRailResponsePending          = errors.New("transfer started but the rail hasn't responded yet")
+	ErrCapabilityDisabled           = errors.New("capability was disabled")
+	ErrInvalidFilePurpose           = errors.New("unknown file purpose")
+	ErrIdempotencyKeyRequired       = errors.New("operation ID given for a call without an idempotency key")
+	ErrIncompleteCardLevelData      = errors.New("level 2/3 card data is missing a required field")
+	ErrInvalidRecurrenceRule        = errors.New("invalid recurrence rule")
+	ErrScheduleInPast               = errors.New("schedule starts in the past")
+	ErrScheduleIndefinite           = errors.New("schedule recurs indefinitely")
+	ErrInvalidDescriptionTemplate   = errors.New("invalid description template")
 
 	// ErrDuplicateBankAccount = errors.New("duplciate bank account or invalid routing number")
 	// ErrNoMicroDeposit       = errors.New("no account with the specified accountID was found or micro-deposits have not been sent for the source")
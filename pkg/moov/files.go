@@ -3,8 +3,10 @@ package moov
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"time"
 )
 
@@ -19,6 +21,20 @@ const (
 	FilePurpose_AccountRequirement         FilePurpose = "account_requirement"
 )
 
+var filePurposes = []FilePurpose{
+	FilePurpose_IdentityVerification,
+	FilePurpose_BusinessVerification,
+	FilePurpose_RepresentativeVerification,
+	FilePurpose_IndividualVerification,
+	FilePurpose_MerchantUnderwriting,
+	FilePurpose_AccountRequirement,
+}
+
+// Valid reports if the purpose is one Moov accepts uploads for
+func (p FilePurpose) Valid() bool {
+	return slices.Contains(filePurposes, p)
+}
+
 type UploadFile struct {
 	FilePurpose FilePurpose
 	Metadata    map[string]string
@@ -48,7 +64,22 @@ type File struct {
 	UpdatedOn      time.Time   `json:"updatedOn"`
 }
 
+// Decision reports if Moov accepted the file and why it was rejected. Files still pending review aren't accepted yet,
+// check FileStatus to tell them apart from rejected ones.
+func (f File) Decision() (accepted bool, reason string) {
+	if f.DecisionReason != nil {
+		reason = *f.DecisionReason
+	}
+	return f.FileStatus == FileStatus_Approved, reason
+}
+
+// UploadFile uploads a document to the account. ErrInvalidFilePurpose is returned without calling Moov if the purpose
+// isn't one of the FilePurpose constants.
 func (c Client) UploadFile(ctx context.Context, accountID string, upload UploadFile) (*File, error) {
+	if !upload.FilePurpose.Valid() {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidFilePurpose, upload.FilePurpose)
+	}
+
 	mdJson, err := json.Marshal(upload.Metadata)
 	if err != nil {
 		return nil, err
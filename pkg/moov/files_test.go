@@ -0,0 +1,56 @@
+package moov_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/moovfinancial/moov-go/pkg/moov"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFile_Decision(t *testing.T) {
+	input := []byte(`{
+		"fileID": "c7a5a2a4-8f4d-4e6c-9bd0-6b1a9b2f0d3e",
+		"fileName": "drivers-license.png",
+		"filePurpose": "identity_verification",
+		"fileStatus": "rejected",
+		"decisionReason": "document is expired",
+		"fileSizeBytes": 48213,
+		"metadata": "{\"side\":\"front\"}",
+		"accountID": "ec7e1848-dc80-4ab0-8827-dd7fc0737b43",
+		"createdOn": "2024-05-01T14:30:00Z",
+		"updatedOn": "2024-05-01T15:02:11Z"
+	}`)
+
+	file := new(moov.File)
+
+	dec := json.NewDecoder(bytes.NewReader(input))
+	dec.DisallowUnknownFields()
+	require.NoError(t, dec.Decode(file))
+
+	accepted, reason := file.Decision()
+	require.False(t, accepted)
+	require.Equal(t, "document is expired", reason)
+
+	accepted, reason = moov.File{FileStatus: moov.FileStatus_Approved}.Decision()
+	require.True(t, accepted)
+	require.Empty(t, reason)
+}
+
+func TestUploadFile_UnknownPurpose(t *testing.T) {
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	_, err := mc.UploadFile(context.Background(), "account-id", moov.UploadFile{
+		FilePurpose: "identity",
+		Filename:    "drivers-license.png",
+		File:        strings.NewReader("png"),
+	})
+	require.ErrorIs(t, err, moov.ErrInvalidFilePurpose)
+}
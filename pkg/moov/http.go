@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	moovgo "github.com/moovfinancial/moov-go"
 )
@@ -24,7 +26,94 @@ func (c *Client) CallHttp(ctx context.Context, endpoint EndpointArg, args ...cal
 		return nil, err
 	}
 
-	url := fmt.Sprintf("https://%s%s", c.Credentials.Host, call.path)
+	if err := c.resolveIdempotencyKey(call); err != nil {
+		return nil, err
+	}
+
+	if call.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, call.timeout)
+		defer cancel()
+	}
+
+	if c.lifecycle != nil {
+		if c.lifecycle.ctx.Err() != nil {
+			return nil, ErrClientClosed
+		}
+
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+
+		stop := context.AfterFunc(c.lifecycle.ctx, cancel)
+		defer stop()
+	}
+
+	call.headers[CorrelationIDHeader] = correlationID(ctx)
+
+	send := c.send
+	if c.tokenAuth != nil && !strings.HasPrefix(call.path, "/oauth2/") {
+		send = c.sendWithToken
+	}
+	if c.dryRun != nil && c.dryRun.skips(call) {
+		send = c.dryRun.send
+	}
+
+	start := time.Now()
+	attempts := 0
+	resp, err := c.sendWithRetries(ctx, call, func(ctx context.Context, call *callBuilder) (*httpCallResponse, error) {
+		attempts++
+		return send(ctx, call)
+	})
+	if err == nil {
+		if maintenance := resp.maintenance(); maintenance != nil {
+			err = maintenance
+		}
+	}
+
+	c.observe(call, start, attempts, resp, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// CallHttpReader makes the call like CallHttp but copies a successful response's body to w as it's read instead of
+// buffering and decoding it, for exports too large to handle as a JSON list. Error responses are still buffered and
+// returned as a CallResponse.
+func (c *Client) CallHttpReader(ctx context.Context, endpoint EndpointArg, w io.Writer, args ...callArg) error {
+	resp, err := c.CallHttp(ctx, endpoint, append(args, callBuilderFn(func(call *callBuilder) error {
+		call.sink = w
+		return nil
+	}))...)
+	if err != nil {
+		return err
+	}
+
+	return CompletedNilOrError(resp)
+}
+
+// delete calls an endpoint that deletes or disables a resource and responds with no content. ErrNotFound is returned
+// along with the response if the resource doesn't exist.
+func (c Client) delete(ctx context.Context, endpoint EndpointArg) error {
+	resp, err := c.CallHttp(ctx, endpoint, AcceptJson())
+	if err != nil {
+		return err
+	}
+
+	switch resp.Status() {
+	case StatusCompleted:
+		return nil
+	case StatusNotFound:
+		return errors.Join(ErrNotFound, resp)
+	default:
+		return resp
+	}
+}
+
+func (c *Client) send(ctx context.Context, call *callBuilder) (*httpCallResponse, error) {
+	url := fmt.Sprintf("https://%s%s%s", c.Credentials.host(), c.basePath, call.path)
 
 	req, err := http.NewRequestWithContext(ctx, call.method, url, call.body)
 	if err != nil {
@@ -38,6 +127,9 @@ func (c *Client) CallHttp(ctx context.Context, endpoint EndpointArg, args ...cal
 	req.URL.RawQuery = qry.Encode()
 
 	for k, v := range call.headers {
+		if k == IdempotencyKeyHeader && c.idempotencyHeader != "" {
+			k = c.idempotencyHeader
+		}
 		req.Header.Add(k, v)
 	}
 	req.Header.Add("User-Agent", fmt.Sprintf("moov-go/%s", moovgo.Version()))
@@ -48,13 +140,36 @@ func (c *Client) CallHttp(ctx context.Context, endpoint EndpointArg, args ...cal
 		req.SetBasicAuth(c.Credentials.PublicKey, c.Credentials.SecretKey)
 	}
 
+	if c.trace != nil {
+		// Tracing is best effort and never fails the call
+		_ = c.trace.request(call.operation(), req)
+	}
+
 	resp, err := c.HttpClient.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, &TransportError{Method: call.method, Path: call.path, Err: err}
 	}
 	defer resp.Body.Close()
 
-	body, _ := io.ReadAll(resp.Body)
+	var body []byte
+	if call.sink != nil && resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		if _, err := io.Copy(call.sink, resp.Body); err != nil {
+			return nil, err
+		}
+	} else {
+		var ok bool
+		if body, ok = c.readBody(resp.Body); !ok {
+			return nil, &ResponseTooLargeError{Method: call.method, Path: call.path, Limit: c.maxResponseBytes}
+		}
+	}
+
+	if c.trace != nil {
+		_ = c.trace.response(resp, body)
+	}
+
+	if c.deprecations != nil {
+		c.deprecations.check(call, resp.Header)
+	}
 
 	decoder := standardDecoder
 	if c.decoder != nil {
@@ -69,6 +184,34 @@ func (c *Client) CallHttp(ctx context.Context, endpoint EndpointArg, args ...cal
 	}, nil
 }
 
+// DefaultMaxResponseBytes is the largest response body read by default, far more than any JSON response Moov sends.
+const DefaultMaxResponseBytes = 64 << 20
+
+// WithMaxResponseBytes limits how much of a response body is read, returning ResponseTooLargeError for anything larger
+// such as a misconfigured host returning a huge HTML page. Zero or less removes the limit. It defaults to
+// DefaultMaxResponseBytes and doesn't apply to bodies streamed with CallHttpReader.
+func WithMaxResponseBytes(n int64) ClientConfigurable {
+	return func(c *Client) error {
+		c.maxResponseBytes = n
+		return nil
+	}
+}
+
+// readBody reads the whole body, ok is false if it's over the client's limit
+func (c *Client) readBody(r io.Reader) (body []byte, ok bool) {
+	if c.maxResponseBytes <= 0 {
+		body, _ = io.ReadAll(r)
+		return body, true
+	}
+
+	// Read one byte past the limit to tell a body at the limit from one over it
+	body, _ = io.ReadAll(io.LimitReader(r, c.maxResponseBytes+1))
+	if int64(len(body)) > c.maxResponseBytes {
+		return nil, false
+	}
+	return body, true
+}
+
 var _ CallResponse = &httpCallResponse{}
 var _ HttpCallResponse = &httpCallResponse{}
 
@@ -82,11 +225,23 @@ type HttpCallResponse interface {
 	StatusCode() int
 }
 
+// ResponseHeaders is implemented by responses that carry HTTP headers, as the responses of CallHttp do. It's separate
+// from CallResponse so other implementations of it keep working, check for it with a type assertion.
+type ResponseHeaders interface {
+	// Returns the first value of the named response header or an empty string if it wasn't set.
+	Header(name string) string
+}
+
+var _ ResponseHeaders = &httpCallResponse{}
+
 type httpCallResponse struct {
 	resp *http.Response
 	body []byte
 
 	decoder Decoder
+
+	// The call wasn't sent, see WithDryRun. Results are left empty.
+	dryRun bool
 }
 
 func (r *httpCallResponse) Status() CallStatus {
@@ -130,6 +285,10 @@ func standardDecoder(r io.Reader, contentType string, item any) error {
 }
 
 func (r *httpCallResponse) Unmarshal(item any) error {
+	if r.dryRun {
+		return nil
+	}
+
 	ct := strings.ToLower(r.resp.Header.Get("content-type"))
 
 	if sb, ok := item.(*strings.Builder); ok {
@@ -152,6 +311,13 @@ func (r *httpCallResponse) StatusCode() int {
 	return 0
 }
 
+func (r *httpCallResponse) Header(name string) string {
+	if r != nil && r.resp != nil {
+		return r.resp.Header.Get(name)
+	}
+	return ""
+}
+
 func (r *httpCallResponse) RequestId() string {
 	if r.resp != nil {
 		return r.resp.Header.Get("X-Request-ID")
@@ -163,6 +329,25 @@ type errorResponse struct {
 	Error string `json:"error"`
 }
 
+// maintenance returns a MaintenanceError if the response is a 503 with a body saying Moov is down for maintenance.
+func (r *httpCallResponse) maintenance() *MaintenanceError {
+	if r.StatusCode() != http.StatusServiceUnavailable || !bytes.Contains(bytes.ToLower(r.body), []byte("maintenance")) {
+		return nil
+	}
+
+	message := strings.TrimSpace(string(r.body))
+	var wrapper errorResponse
+	if json.Unmarshal(r.body, &wrapper) == nil {
+		message = wrapper.Error
+	}
+
+	return &MaintenanceError{
+		Message:    message,
+		RetryAfter: retryAfter(r.resp.Header.Get("Retry-After"), time.Now()),
+		Err:        r,
+	}
+}
+
 func (r *httpCallResponse) Error() string {
 	generic := fmt.Sprintf("error from moov - status: %s http.request_id: %s http.status_code: %d", r.Status().Name, r.RequestId(), r.StatusCode())
 
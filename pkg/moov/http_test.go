@@ -1,9 +1,15 @@
 package moov
 
 import (
+	"context"
+	"math/rand/v2"
+	"net"
 	"net/http"
+	"net/http/httptest"
 	"strings"
+	"syscall"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -34,3 +40,277 @@ error from moov - status: bad_request http.request_id:  http.status_code: 400
 		require.Equal(t, expected, resp.Error())
 	})
 }
+
+func TestHTTPCallResponse_StatusAndHeaders(t *testing.T) {
+	cases := []struct {
+		code   int
+		status CallStatus
+	}{
+		{http.StatusOK, StatusCompleted},
+		{http.StatusNoContent, StatusCompleted},
+		{http.StatusCreated, StatusStarted},
+		{http.StatusConflict, StatusStateConflict},
+		{http.StatusTooManyRequests, StatusRateLimited},
+		{http.StatusBadGateway, StatusServerError},
+	}
+
+	for _, c := range cases {
+		t.Run(http.StatusText(c.code), func(t *testing.T) {
+			resp := &httpCallResponse{
+				resp: &http.Response{
+					StatusCode: c.code,
+					Header: http.Header{
+						"X-Request-Id": []string{"request-id"},
+						"Retry-After":  []string{"5"},
+					},
+				},
+			}
+
+			require.Equal(t, c.code, resp.StatusCode())
+			require.Equal(t, c.status, resp.Status())
+			require.Equal(t, "5", resp.Header("retry-after"))
+			require.Equal(t, "request-id", resp.Header("X-Request-ID"))
+			require.Empty(t, resp.Header("X-Missing"))
+		})
+	}
+
+	t.Run("nil response", func(t *testing.T) {
+		resp := &httpCallResponse{}
+		require.Equal(t, 0, resp.StatusCode())
+		require.Empty(t, resp.Header("X-Request-ID"))
+	})
+}
+
+func TestCallHttp_TransportError(t *testing.T) {
+	// Grab a free port and close it so nothing is listening
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := l.Addr().String()
+	require.NoError(t, l.Close())
+
+	mc, err := NewClient(WithCredentials(Credentials{PublicKey: "public-key", SecretKey: "secret-key", Host: addr}))
+	require.NoError(t, err)
+
+	err = mc.Ping(context.Background())
+
+	var transportErr *TransportError
+	require.ErrorAs(t, err, &transportErr)
+	require.Equal(t, http.MethodGet, transportErr.Method)
+	require.Equal(t, pathPing, transportErr.Path)
+	require.ErrorIs(t, err, syscall.ECONNREFUSED)
+
+	require.Nil(t, ErrorAsHttpCallResponse(err))
+}
+
+func TestCallHttp_WithTimeout(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	mc, err := NewClient(
+		WithCredentials(Credentials{PublicKey: "public-key", SecretKey: "secret-key", Host: srv.Listener.Addr().String()}),
+		WithHttpClient(srv.Client()))
+	require.NoError(t, err)
+
+	t.Run("per-call timeout applied", func(t *testing.T) {
+		start := time.Now()
+		_, err := mc.ListTransfers(context.Background(), "account-id", WithTimeout(50*time.Millisecond))
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("shorter caller deadline kept", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		_, err := mc.ListTransfers(ctx, "account-id", WithTimeout(time.Hour))
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Less(t, time.Since(start), time.Second)
+	})
+}
+
+func TestWithQueryParam(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "ach", r.URL.Query().Get("rail"))
+		require.Equal(t, "completed", r.URL.Query().Get("status"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("[]"))
+	}))
+	t.Cleanup(srv.Close)
+
+	mc, err := NewClient(
+		WithCredentials(Credentials{PublicKey: "public-key", SecretKey: "secret-key", Host: srv.Listener.Addr().String()}),
+		WithHttpClient(srv.Client()))
+	require.NoError(t, err)
+
+	_, err = mc.ListTransfers(context.Background(), "account-id", WithTransferStatus("completed"), WithQueryParam("rail", "ach"))
+	require.NoError(t, err)
+
+	_, err = mc.ListTransfers(context.Background(), "account-id", WithQueryParam("", "ach"))
+	require.ErrorContains(t, err, "key must not be empty")
+}
+
+func TestClient_Delete(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodDelete, r.Method)
+
+		switch r.URL.Path {
+		case "/accounts/account-id/cards/deleted":
+			w.WriteHeader(http.StatusNoContent)
+		case "/accounts/account-id/cards/missing":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":"card not found"}`))
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"error":"internal error"}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	mc, err := NewClient(
+		WithCredentials(Credentials{PublicKey: "public-key", SecretKey: "secret-key", Host: srv.Listener.Addr().String()}),
+		WithHttpClient(srv.Client()))
+	require.NoError(t, err)
+
+	t.Run("204", func(t *testing.T) {
+		require.NoError(t, mc.DisableCard(context.Background(), "account-id", "deleted"))
+	})
+
+	t.Run("404", func(t *testing.T) {
+		err := mc.DisableCard(context.Background(), "account-id", "missing")
+		require.ErrorIs(t, err, ErrNotFound)
+		require.Equal(t, StatusNotFound, ErrorAsCallResponse(err).Status())
+	})
+
+	t.Run("other", func(t *testing.T) {
+		err := mc.DisableCard(context.Background(), "account-id", "errored")
+		require.NotErrorIs(t, err, ErrNotFound)
+		require.Equal(t, StatusServerError, ErrorAsCallResponse(err).Status())
+	})
+}
+
+func TestCallHttpReader_CSV(t *testing.T) {
+	export := "transferID,status,amount\ntransfer-1,completed,1000\ntransfer-2,failed,250\n"
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/accounts/account-id/transfers" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":"not found"}`))
+			return
+		}
+
+		require.Equal(t, "text/csv", r.Header.Get("Accept"))
+		w.Header().Set("Content-Type", "text/csv")
+		w.Write([]byte(export))
+	}))
+	t.Cleanup(srv.Close)
+
+	mc, err := NewClient(
+		WithCredentials(Credentials{PublicKey: "public-key", SecretKey: "secret-key", Host: srv.Listener.Addr().String()}),
+		WithHttpClient(srv.Client()))
+	require.NoError(t, err)
+
+	t.Run("streams the export", func(t *testing.T) {
+		var buf strings.Builder
+		err := mc.CallHttpReader(context.Background(), Endpoint(http.MethodGet, pathTransfers, "account-id"), &buf, AcceptCSV())
+		require.NoError(t, err)
+		require.Equal(t, export, buf.String())
+	})
+
+	t.Run("error responses aren't streamed", func(t *testing.T) {
+		var buf strings.Builder
+		err := mc.CallHttpReader(context.Background(), Endpoint(http.MethodGet, pathTransfer, "account-id", "transfer-id"), &buf, AcceptCSV())
+		require.Equal(t, StatusNotFound, ErrorAsCallResponse(err).Status())
+		require.Empty(t, buf.String())
+	})
+}
+
+func TestWithMaxResponseBytes(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte("<html>" + strings.Repeat("<p>not moov</p>", 100) + "</html>"))
+	}))
+	t.Cleanup(srv.Close)
+
+	mc, err := NewClient(
+		WithCredentials(Credentials{PublicKey: "public-key", SecretKey: "secret-key", Host: srv.Listener.Addr().String()}),
+		WithHttpClient(srv.Client()),
+		WithMaxResponseBytes(1024))
+	require.NoError(t, err)
+
+	_, err = mc.GetAccount(context.Background(), "account-id")
+
+	var tooLarge *ResponseTooLargeError
+	require.ErrorAs(t, err, &tooLarge)
+	require.Equal(t, int64(1024), tooLarge.Limit)
+	require.Equal(t, "/accounts/account-id", tooLarge.Path)
+}
+
+func TestRetryJitter(t *testing.T) {
+	const backoff = 400 * time.Millisecond
+
+	jittered := func(mode JitterMode) []time.Duration {
+		c, err := NewClient(WithCredentials(Credentials{PublicKey: "public", SecretKey: "secret"}), WithRetries(3, backoff), WithRetryJitter(mode))
+		require.NoError(t, err)
+		c.retry.rand = rand.New(rand.NewPCG(1, 2))
+
+		waits := make([]time.Duration, 100)
+		for i := range waits {
+			waits[i] = c.retry.jittered(backoff)
+		}
+		return waits
+	}
+
+	t.Run("defaults to full jitter", func(t *testing.T) {
+		c, err := NewClient(WithCredentials(Credentials{PublicKey: "public", SecretKey: "secret"}), WithRetries(3, backoff))
+		require.NoError(t, err)
+		require.Equal(t, FullJitter, c.retry.jitter)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		for _, wait := range jittered(NoJitter) {
+			require.Equal(t, backoff, wait)
+		}
+	})
+
+	t.Run("full", func(t *testing.T) {
+		waits := jittered(FullJitter)
+		for _, wait := range waits {
+			require.GreaterOrEqual(t, wait, time.Duration(0))
+			require.LessOrEqual(t, wait, backoff)
+		}
+		require.Equal(t, []time.Duration{307749308, 246574490, 313771201, 318638632}, waits[:4])
+
+		// The same seed waits the same
+		require.Equal(t, waits, jittered(FullJitter))
+	})
+
+	t.Run("equal", func(t *testing.T) {
+		waits := jittered(EqualJitter)
+		for _, wait := range waits {
+			require.GreaterOrEqual(t, wait, backoff/2)
+			require.LessOrEqual(t, wait, backoff)
+		}
+		require.Equal(t, []time.Duration{353874654, 323287245, 356885601, 359319316}, waits[:4])
+	})
+
+	t.Run("doesn't turn on retries", func(t *testing.T) {
+		c, err := NewClient(WithCredentials(Credentials{PublicKey: "public", SecretKey: "secret"}), WithRetryJitter(EqualJitter))
+		require.NoError(t, err)
+		require.False(t, c.retry.enabled)
+	})
+
+	t.Run("unknown mode", func(t *testing.T) {
+		_, err := NewClient(WithCredentials(Credentials{PublicKey: "public", SecretKey: "secret"}), WithRetryJitter("random"))
+		require.ErrorContains(t, err, "unknown retry jitter mode")
+	})
+}
@@ -0,0 +1,123 @@
+package moov
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// IdempotencyKeyHeader is the header Moov reads the idempotency key of a create from. See WithIdempotencyHeader to send
+// the key in another header.
+const IdempotencyKeyHeader = "X-Idempotency-Key"
+
+// IdempotencyStore remembers the idempotency key used for a logical operation so retrying the operation reuses the
+// same key, even from another process or after a restart when the store is durable. Implementations must be safe to
+// call from multiple goroutines.
+type IdempotencyStore interface {
+	// Get returns the key stored for the operation if there is one.
+	Get(operationID string) (uuid.UUID, bool)
+
+	// Set stores the key for the operation unless one is already stored. It returns the key that's stored, and true if
+	// that key was stored by an earlier call.
+	Set(operationID string, key uuid.UUID) (uuid.UUID, bool)
+}
+
+// MemoryIdempotencyStore is an IdempotencyStore that only lasts as long as the process. It's used by default.
+type MemoryIdempotencyStore struct {
+	mu   sync.Mutex
+	keys map[string]uuid.UUID
+}
+
+func NewMemoryIdempotencyStore() *MemoryIdempotencyStore {
+	return &MemoryIdempotencyStore{
+		keys: make(map[string]uuid.UUID),
+	}
+}
+
+func (s *MemoryIdempotencyStore) Get(operationID string) (uuid.UUID, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.keys[operationID]
+	return key, ok
+}
+
+func (s *MemoryIdempotencyStore) Set(operationID string, key uuid.UUID) (uuid.UUID, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if existing, ok := s.keys[operationID]; ok {
+		return existing, true
+	}
+
+	s.keys[operationID] = key
+	return key, false
+}
+
+// WithIdempotencyStore replaces the in-memory store used to remember the idempotency keys of operations.
+func WithIdempotencyStore(store IdempotencyStore) ClientConfigurable {
+	return func(c *Client) error {
+		c.idempotencyStore = store
+		return nil
+	}
+}
+
+// WithIdempotencyHeader sends idempotency keys in the named header instead of IdempotencyKeyHeader, for gateways in
+// front of Moov that expect them under another name.
+func WithIdempotencyHeader(name string) ClientConfigurable {
+	return func(c *Client) error {
+		if name == "" {
+			return errors.New("idempotency header name must not be empty")
+		}
+		c.idempotencyHeader = http.CanonicalHeaderKey(name)
+		return nil
+	}
+}
+
+func withOperationID(operationID string) callArg {
+	return callBuilderFn(func(call *callBuilder) error {
+		call.operationID = operationID
+		return nil
+	})
+}
+
+// resolveIdempotencyKey swaps the call's idempotency key for the one stored for its operation, or stores the call's
+// key if it's the first attempt at the operation. Calls made WithoutIdempotencyKey have their key removed.
+func (c *Client) resolveIdempotencyKey(call *callBuilder) error {
+	if call.withoutIdempotencyKey {
+		if call.operationID != "" {
+			return fmt.Errorf("%w: operation %s", ErrIdempotencyKeyRequired, call.operationID)
+		}
+		delete(call.headers, IdempotencyKeyHeader)
+		return nil
+	}
+
+	if call.operationID == "" || c.idempotencyStore == nil {
+		return nil
+	}
+
+	if key, ok := c.idempotencyStore.Get(call.operationID); ok {
+		call.headers[IdempotencyKeyHeader] = key.String()
+		return nil
+	}
+
+	key, err := uuid.Parse(call.headers[IdempotencyKeyHeader])
+	if err != nil {
+		return fmt.Errorf("idempotency key for operation %s: %w", call.operationID, err)
+	}
+
+	key, _ = c.idempotencyStore.Set(call.operationID, key)
+	call.headers[IdempotencyKeyHeader] = key.String()
+
+	return nil
+}
+
+// sentIdempotencyKey reports if the request resp answers carried an idempotency key, so a conflict could be down to
+// the key having been used before.
+func (c Client) sentIdempotencyKey(resp CallResponse) bool {
+	r, ok := resp.(*httpCallResponse)
+	return ok && r.resp != nil && r.resp.Request != nil && r.resp.Request.Header.Get(c.idempotencyHeader) != ""
+}
@@ -0,0 +1,111 @@
+package moov_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moovfinancial/moov-go/pkg/moov"
+)
+
+func Test_IdempotencyStore_ReusesKey(t *testing.T) {
+	keys := []string{}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("X-Idempotency-Key"))
+		writeJson(t, w, http.StatusOK, moov.TransferStarted{TransferID: "transfer-id"})
+	})
+
+	// Shared between both clients, standing in for a durable store surviving a restart
+	store := moov.NewMemoryIdempotencyStore()
+
+	create := func(mc *moov.Client, operationID string) {
+		_, err := mc.CreateTransfer(BgCtx(), "account-id", moov.CreateTransfer{
+			Amount: moov.Amount{Currency: "USD", Value: 100},
+		}, moov.WithTransferOperationID(operationID)).Started()
+		require.NoError(t, err)
+	}
+
+	first := NewMockClient(t, handler, moov.WithIdempotencyStore(store))
+	create(first, "payout-1")
+
+	restarted := NewMockClient(t, handler, moov.WithIdempotencyStore(store))
+	create(restarted, "payout-1")
+	create(restarted, "payout-2")
+
+	require.Len(t, keys, 3)
+	require.Equal(t, keys[0], keys[1])
+	require.NotEqual(t, keys[0], keys[2])
+
+	stored, ok := store.Get("payout-1")
+	require.True(t, ok)
+	require.Equal(t, keys[0], stored.String())
+}
+
+func Test_IdempotencyStore_Schedules(t *testing.T) {
+	keys := []string{}
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("X-Idempotency-Key"))
+		writeJson(t, w, http.StatusOK, moov.Schedule{ScheduleID: "schedule-id"})
+	}))
+
+	for range 2 {
+		_, err := mc.CreateSchedule(BgCtx(), "account-id", newMockSchedule(), moov.WithScheduleOperationID("subscription-1"))
+		require.NoError(t, err)
+	}
+
+	require.Len(t, keys, 2)
+	require.Equal(t, keys[0], keys[1])
+}
+
+func Test_WithoutIdempotencyKey(t *testing.T) {
+	requests := 0
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, sent := r.Header["X-Idempotency-Key"]
+		require.False(t, sent)
+		writeJson(t, w, http.StatusOK, moov.TransferStarted{TransferID: "transfer-id"})
+	}))
+
+	transfer := moov.CreateTransfer{
+		Amount: moov.Amount{Currency: "USD", Value: 100},
+	}
+
+	_, err := mc.CreateTransfer(BgCtx(), "account-id", transfer, moov.WithoutTransferIdempotencyKey()).Started()
+	require.NoError(t, err)
+
+	_, err = mc.CreateTransfer(BgCtx(), "account-id", transfer,
+		moov.WithoutTransferIdempotencyKey(),
+		moov.WithTransferOperationID("payout-1"),
+	).Started()
+	require.ErrorIs(t, err, moov.ErrIdempotencyKeyRequired)
+
+	require.Equal(t, 1, requests)
+}
+
+func Test_WithIdempotencyHeader(t *testing.T) {
+	var headers []http.Header
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Clone())
+		writeJson(t, w, http.StatusOK, moov.TransferStarted{TransferID: "transfer-id"})
+	}), moov.WithIdempotencyHeader("idempotency-key"))
+
+	_, err := mc.CreateTransfer(BgCtx(), "account-id", moov.CreateTransfer{
+		Amount: moov.Amount{Currency: "USD", Value: 100},
+	}).Started()
+	require.NoError(t, err)
+
+	key := "7a1e4a7c-2b0e-4b5f-9d0a-3c4e5f6a7b8c"
+	_, err = mc.CallHttp(BgCtx(), moov.Endpoint(http.MethodPost, "/accounts/%s/transfers", "account-id"), moov.IdempotencyKey(key))
+	require.NoError(t, err)
+
+	require.Len(t, headers, 2)
+	require.NotEmpty(t, headers[0].Get("Idempotency-Key"))
+	require.Equal(t, key, headers[1].Get("Idempotency-Key"))
+	for _, h := range headers {
+		require.Empty(t, h.Values(moov.IdempotencyKeyHeader))
+	}
+
+	_, err = moov.NewClient(moov.WithIdempotencyHeader(""))
+	require.Error(t, err)
+}
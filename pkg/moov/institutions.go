@@ -2,7 +2,10 @@ package moov
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"slices"
 )
 
 type FinancialInstitutions struct {
@@ -60,6 +63,11 @@ type Rail string
 var (
 	RailAch  Rail = "ach"
 	RailWire Rail = "wire"
+
+	// Rails payment methods move money over that aren't searchable institution rails
+	RailRtp    Rail = "rtp"
+	RailCard   Rail = "card"
+	RailWallet Rail = "moov-wallet"
 )
 
 type ListInstitutionsFailter callArg
@@ -94,11 +102,243 @@ func WithInstitutionLimit(limit int) ListTransactionFilter {
 }
 
 func (c Client) ListInstitutions(ctx context.Context, rail Rail, opts ...ListInstitutionsFailter) (*FinancialInstitutions, error) {
+	cacheKey, cached := c.institutionCacheKey(rail, opts)
+	if cached {
+		if institutions, ok := c.institutionCache.get(cacheKey); ok {
+			return institutions, nil
+		}
+	}
+
 	args := prependArgs(opts, AcceptJson())
 	resp, err := c.CallHttp(ctx, Endpoint(http.MethodGet, pathInstitutions, string(rail)), args...)
 	if err != nil {
 		return nil, err
 	}
 
-	return CompletedObjectOrError[FinancialInstitutions](resp)
+	institutions, err := CompletedObjectOrError[FinancialInstitutions](resp)
+	if err == nil && cached {
+		c.institutionCache.set(cacheKey, *institutions)
+	}
+	return institutions, err
+}
+
+// institutionCacheKey returns the key to cache the search under, and false if there's no cache or the search is
+// filtered by more than a routing number.
+func (c Client) institutionCacheKey(rail Rail, opts []ListInstitutionsFailter) (string, bool) {
+	if c.institutionCache == nil {
+		return "", false
+	}
+
+	call, err := newCall(Endpoint(http.MethodGet, pathInstitutions, string(rail)), prependArgs(opts)...)
+	if err != nil || len(call.params) != 1 || call.params["routingNumber"] == "" {
+		return "", false
+	}
+	return institutionCacheKey(rail, call.params["routingNumber"]), true
+}
+
+// ParticipationStatus describes if an institution can receive payments over a rail.
+type ParticipationStatus string
+
+// List of ParticipationStatus
+const (
+	ParticipationStatus_Participating    ParticipationStatus = "participating"
+	ParticipationStatus_NotParticipating ParticipationStatus = "not-participating"
+)
+
+// Institution combines the FedACH and FedWire participation details for a routing number.
+type Institution struct {
+	RoutingNumber string
+	Name          string
+
+	// Federal Reserve bank servicing the institution for ACH.
+	ServicingFRBNumber string
+	// Set when the routing number is being retired in favor of a new one.
+	NewRoutingNumber string
+
+	AchTransferStatus  ParticipationStatus
+	WireTransferStatus ParticipationStatus
+
+	Ach  *AchParticipant
+	Wire *WireParticipant
+}
+
+// IsRetiring reports if the routing number is being replaced by NewRoutingNumber.
+func (i Institution) IsRetiring() bool {
+	return i.NewRoutingNumber != "" && i.NewRoutingNumber != i.RoutingNumber
+}
+
+// Supports reports if the institution participates in the given rail.
+func (i Institution) Supports(rail Rail) bool {
+	switch rail {
+	case RailAch:
+		return i.AchTransferStatus == ParticipationStatus_Participating
+	case RailWire:
+		return i.WireTransferStatus == ParticipationStatus_Participating
+	default:
+		return false
+	}
+}
+
+// SearchInstitutions searches both the ACH and wire participants and combines the results by routing number so
+// callers can see which rails an institution supports in one place.
+func (c Client) SearchInstitutions(ctx context.Context, opts ...ListInstitutionsFailter) ([]Institution, error) {
+	ach, err := c.ListInstitutions(ctx, RailAch, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	wire, err := c.ListInstitutions(ctx, RailWire, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var institutions []Institution
+	byRouting := make(map[string]int)
+
+	find := func(routingNumber string) *Institution {
+		if i, ok := byRouting[routingNumber]; ok {
+			return &institutions[i]
+		}
+
+		byRouting[routingNumber] = len(institutions)
+		institutions = append(institutions, Institution{
+			RoutingNumber:      routingNumber,
+			AchTransferStatus:  ParticipationStatus_NotParticipating,
+			WireTransferStatus: ParticipationStatus_NotParticipating,
+		})
+		return &institutions[len(institutions)-1]
+	}
+
+	for _, p := range ach.AchParticipants {
+		inst := find(p.RoutingNumber)
+		inst.Ach = &p
+		inst.Name = p.CustomerName
+		inst.ServicingFRBNumber = p.ServicingFRBNumber
+		inst.NewRoutingNumber = p.NewRoutingNumber
+		inst.AchTransferStatus = ParticipationStatus_Participating
+	}
+
+	for _, p := range wire.WireParticipants {
+		inst := find(p.RoutingNumber)
+		inst.Wire = &p
+		if inst.Name == "" {
+			inst.Name = p.CustomerName
+		}
+
+		// FedWire marks institutions that are eligible for funds transfers with a "Y"
+		if p.FundsTransferStatus == "Y" {
+			inst.WireTransferStatus = ParticipationStatus_Participating
+		}
+	}
+
+	return institutions, nil
+}
+
+// InstitutionValidation is the result of checking a routing number with ValidateRoutingNumbers.
+type InstitutionValidation struct {
+	RoutingNumber string
+	// The routing number is well formed and belongs to a FedACH or FedWire participant.
+	Valid bool
+	// The institution participates in the rail validated for.
+	SupportsRail bool
+	// Why the routing number isn't valid or doesn't support the rail, empty if it does.
+	Reason string
+
+	// Set when the routing number was found.
+	Institution *Institution
+}
+
+const routingValidationConcurrency = 5
+
+// ValidateRoutingNumbers checks each routing number's checksum and searches the institution directories for the ones
+// that pass, reporting if each is valid and supports the rail. Searches run concurrently, a few at a time. Results are
+// keyed by routing number. If any search fails the results of the others are still returned along with the errors.
+func (c Client) ValidateRoutingNumbers(ctx context.Context, rail Rail, routingNumbers []string) (map[string]InstitutionValidation, error) {
+	if rail != RailAch && rail != RailWire {
+		return nil, fmt.Errorf("routing numbers can only be validated for %s or %s, not %s", RailAch, RailWire, rail)
+	}
+
+	results := make(map[string]InstitutionValidation, len(routingNumbers))
+
+	// Routing numbers with a valid checksum are searched for, each once
+	var search []string
+	for _, routingNumber := range routingNumbers {
+		if _, ok := results[routingNumber]; ok {
+			continue
+		}
+
+		if !validRoutingChecksum(routingNumber) {
+			results[routingNumber] = InstitutionValidation{
+				RoutingNumber: routingNumber,
+				Reason:        "not a 9 digit routing number with a valid checksum",
+			}
+			continue
+		}
+
+		results[routingNumber] = InstitutionValidation{RoutingNumber: routingNumber}
+		search = append(search, routingNumber)
+	}
+
+	found := make([]InstitutionValidation, len(search))
+	errs := fanOut(len(search), routingValidationConcurrency, func(i int) error {
+		var err error
+		found[i], err = c.validateRoutingNumber(ctx, rail, search[i])
+		if err != nil {
+			return fmt.Errorf("routing number %s: %w", search[i], err)
+		}
+		return nil
+	})
+
+	for i, routingNumber := range search {
+		if errs[i] != nil {
+			delete(results, routingNumber)
+			continue
+		}
+		results[routingNumber] = found[i]
+	}
+
+	return results, errors.Join(errs...)
+}
+
+func (c Client) validateRoutingNumber(ctx context.Context, rail Rail, routingNumber string) (InstitutionValidation, error) {
+	result := InstitutionValidation{RoutingNumber: routingNumber}
+
+	institutions, err := c.SearchInstitutions(ctx, WithInstitutionRoutingNumber(routingNumber))
+	if err != nil {
+		return result, err
+	}
+
+	// Searches match on prefixes, so only take an exact match
+	i := slices.IndexFunc(institutions, func(inst Institution) bool {
+		return inst.RoutingNumber == routingNumber
+	})
+	if i < 0 {
+		result.Reason = "no institution has the routing number"
+		return result, nil
+	}
+
+	result.Valid = true
+	result.Institution = &institutions[i]
+	result.SupportsRail = result.Institution.Supports(rail)
+	if !result.SupportsRail {
+		result.Reason = fmt.Sprintf("institution doesn't participate in %s", rail)
+	}
+	return result, nil
+}
+
+// validRoutingChecksum reports if the routing number is 9 digits with a valid ABA checksum.
+func validRoutingChecksum(routingNumber string) bool {
+	if len(routingNumber) != 9 {
+		return false
+	}
+
+	weights := [3]int{3, 7, 1}
+	sum := 0
+	for i, r := range routingNumber {
+		if r < '0' || r > '9' {
+			return false
+		}
+		sum += int(r-'0') * weights[i%3]
+	}
+	return sum%10 == 0
 }
@@ -2,10 +2,13 @@ package moov_test
 
 import (
 	"context"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/moovfinancial/moov-go/pkg/moov"
 
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -45,3 +48,135 @@ func (s *InstitutionTestSuite) TestListInstitutions() {
 	s.Greater(len(resp.AchParticipants), 0)
 	s.Len(resp.WireParticipants, 0)
 }
+
+func TestSearchInstitutions(t *testing.T) {
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "021000021", r.URL.Query().Get("routingNumber"))
+
+		switch r.URL.Path {
+		case "/institutions/ach/search":
+			writeJson(t, w, http.StatusOK, moov.FinancialInstitutions{
+				AchParticipants: []moov.AchParticipant{{
+					RoutingNumber:      "021000021",
+					ServicingFRBNumber: "021001208",
+					NewRoutingNumber:   "021000089",
+					CustomerName:       "JPMORGAN CHASE",
+				}},
+				WireParticipants: []moov.WireParticipant{},
+			})
+		case "/institutions/wire/search":
+			writeJson(t, w, http.StatusOK, moov.FinancialInstitutions{
+				AchParticipants:  []moov.AchParticipant{},
+				WireParticipants: []moov.WireParticipant{},
+			})
+		default:
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+	}))
+
+	institutions, err := mc.SearchInstitutions(context.Background(), moov.WithInstitutionRoutingNumber("021000021"))
+	require.NoError(t, err)
+	require.Len(t, institutions, 1)
+
+	inst := institutions[0]
+	require.Equal(t, "JPMORGAN CHASE", inst.Name)
+	require.Equal(t, "021001208", inst.ServicingFRBNumber)
+	require.Equal(t, "021000089", inst.NewRoutingNumber)
+	require.True(t, inst.IsRetiring())
+
+	require.Equal(t, moov.ParticipationStatus_Participating, inst.AchTransferStatus)
+	require.Equal(t, moov.ParticipationStatus_NotParticipating, inst.WireTransferStatus)
+	require.True(t, inst.Supports(moov.RailAch))
+	require.False(t, inst.Supports(moov.RailWire))
+	require.NotNil(t, inst.Ach)
+	require.Nil(t, inst.Wire)
+}
+
+func TestValidateRoutingNumbers(t *testing.T) {
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		institutions := moov.FinancialInstitutions{
+			AchParticipants:  []moov.AchParticipant{},
+			WireParticipants: []moov.WireParticipant{},
+		}
+		if r.URL.Path == "/institutions/ach/search" && r.URL.Query().Get("routingNumber") == "021000021" {
+			institutions.AchParticipants = append(institutions.AchParticipants, moov.AchParticipant{
+				RoutingNumber: "021000021",
+				CustomerName:  "JPMORGAN CHASE",
+			})
+		}
+		writeJson(t, w, http.StatusOK, institutions)
+	}))
+
+	results, err := mc.ValidateRoutingNumbers(context.Background(), moov.RailAch, []string{"021000021", "123456789", "011000015", "021000021"})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	valid := results["021000021"]
+	require.True(t, valid.Valid)
+	require.True(t, valid.SupportsRail)
+	require.Equal(t, "JPMORGAN CHASE", valid.Institution.Name)
+
+	badChecksum := results["123456789"]
+	require.False(t, badChecksum.Valid)
+	require.Contains(t, badChecksum.Reason, "checksum")
+
+	unknown := results["011000015"]
+	require.False(t, unknown.Valid)
+	require.Nil(t, unknown.Institution)
+
+	t.Run("rail support", func(t *testing.T) {
+		results, err := mc.ValidateRoutingNumbers(context.Background(), moov.RailWire, []string{"021000021"})
+		require.NoError(t, err)
+		require.True(t, results["021000021"].Valid)
+		require.False(t, results["021000021"].SupportsRail)
+	})
+}
+
+func TestInstitutionCache(t *testing.T) {
+	calls := map[string]int{}
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls[r.URL.Path+"?"+r.URL.RawQuery]++
+
+		switch r.URL.Path {
+		case "/institutions/ach/search":
+			writeJson(t, w, http.StatusOK, moov.FinancialInstitutions{
+				AchParticipants: []moov.AchParticipant{{
+					RoutingNumber: r.URL.Query().Get("routingNumber"),
+					CustomerName:  "JPMORGAN CHASE",
+				}},
+				WireParticipants: []moov.WireParticipant{},
+			})
+		case "/institutions/wire/search":
+			writeJson(t, w, http.StatusOK, moov.FinancialInstitutions{
+				AchParticipants:  []moov.AchParticipant{},
+				WireParticipants: []moov.WireParticipant{},
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}), moov.WithInstitutionCache(time.Minute))
+
+	first, err := mc.SearchInstitutions(BgCtx(), moov.WithInstitutionRoutingNumber("021000021"))
+	require.NoError(t, err)
+	require.Len(t, first, 1)
+	require.Len(t, calls, 2)
+
+	// The second lookup is served from the cache
+	second, err := mc.SearchInstitutions(BgCtx(), moov.WithInstitutionRoutingNumber("021000021"))
+	require.NoError(t, err)
+	require.Equal(t, first, second)
+	require.Equal(t, map[string]int{
+		"/institutions/ach/search?routingNumber=021000021":  1,
+		"/institutions/wire/search?routingNumber=021000021": 1,
+	}, calls)
+
+	// Other routing numbers and searches with other filters still call Moov
+	_, err = mc.ListInstitutions(BgCtx(), moov.RailAch, moov.WithInstitutionRoutingNumber("011000015"))
+	require.NoError(t, err)
+	_, err = mc.ListInstitutions(BgCtx(), moov.RailAch, moov.WithInstitutionRoutingNumber("021000021"), moov.WithInstitutionState("NY"))
+	require.NoError(t, err)
+	require.Equal(t, 1, calls["/institutions/ach/search?routingNumber=011000015"])
+	require.Equal(t, 1, calls["/institutions/ach/search?routingNumber=021000021&state=NY"])
+	require.Equal(t, 1, calls["/institutions/ach/search?routingNumber=021000021"])
+}
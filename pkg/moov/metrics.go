@@ -0,0 +1,54 @@
+package moov
+
+import (
+	"time"
+)
+
+// Metric describes a call made with CallHttp once it's completed, with any retries counted rather than reported
+// separately.
+type Metric struct {
+	// Name given with WithOperationName, or the method and path template such as "GET /accounts/%s/transfers".
+	Operation string
+	Method    string
+	// Path of the endpoint with placeholders in place of IDs, such as /accounts/%s/transfers
+	PathTemplate string
+	// Status code of the final response, zero if no response was received.
+	StatusCode int
+	// Time from the first attempt until the final response, including waits between retries.
+	Duration time.Duration
+	// Attempts made after the first.
+	RetryCount int
+	// Error returned by the call, such as a TransportError. Error responses are returned as a CallResponse so they're
+	// only reported through StatusCode.
+	Err error
+}
+
+// WithMetricsObserver calls observer after each call completes, for feeding call counts, latencies, and statuses into
+// a metrics library such as Prometheus or StatsD. observer is called from the goroutine making the call so it should
+// return quickly.
+func WithMetricsObserver(observer func(Metric)) ClientConfigurable {
+	return func(c *Client) error {
+		c.metricsObserver = observer
+		return nil
+	}
+}
+
+func (c *Client) observe(call *callBuilder, start time.Time, attempts int, resp *httpCallResponse, err error) {
+	if c.metricsObserver == nil {
+		return
+	}
+
+	metric := Metric{
+		Operation:    call.operation(),
+		Method:       call.method,
+		PathTemplate: call.endpoint,
+		Duration:     time.Since(start),
+		RetryCount:   max(attempts-1, 0),
+		Err:          err,
+	}
+	if resp != nil {
+		metric.StatusCode = resp.resp.StatusCode
+	}
+
+	c.metricsObserver(metric)
+}
@@ -0,0 +1,70 @@
+package moov_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moovfinancial/moov-go/pkg/moov"
+)
+
+func TestWithMetricsObserver(t *testing.T) {
+	requests := map[string]int{}
+	var metrics []moov.Metric
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		switch {
+		case r.URL.Path == "/accounts/account-id/bank-accounts/bank-account-id":
+			writeJson(t, w, http.StatusOK, moov.BankAccount{BankAccountID: "bank-account-id"})
+		case requests[r.URL.Path] == 1:
+			writeJson(t, w, http.StatusBadGateway, map[string]string{})
+		default:
+			writeJson(t, w, http.StatusNotFound, map[string]string{})
+		}
+	}), moov.WithRetries(2, 0), moov.WithMetricsObserver(func(m moov.Metric) {
+		metrics = append(metrics, m)
+	}))
+
+	_, err := mc.GetBankAccount(BgCtx(), "account-id", "bank-account-id")
+	require.NoError(t, err)
+	require.Len(t, metrics, 1)
+	require.Equal(t, "GET /accounts/%s/bank-accounts/%s", metrics[0].Operation)
+	require.Equal(t, http.MethodGet, metrics[0].Method)
+	require.Equal(t, "/accounts/%s/bank-accounts/%s", metrics[0].PathTemplate)
+	require.Equal(t, http.StatusOK, metrics[0].StatusCode)
+	require.Zero(t, metrics[0].RetryCount)
+	require.NoError(t, metrics[0].Err)
+	require.Positive(t, metrics[0].Duration)
+
+	t.Run("retries are collapsed", func(t *testing.T) {
+		metrics = nil
+
+		_, err := mc.GetBankAccount(BgCtx(), "account-id", "other-bank-account-id")
+		require.Error(t, err)
+		require.Len(t, metrics, 1)
+		require.Equal(t, http.StatusNotFound, metrics[0].StatusCode)
+		require.Equal(t, 1, metrics[0].RetryCount)
+	})
+}
+
+func TestWithOperationName(t *testing.T) {
+	var metrics []moov.Metric
+	trace := &bytes.Buffer{}
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJson(t, w, http.StatusOK, map[string]string{})
+	}), moov.WithHTTPTrace(trace), moov.WithMetricsObserver(func(m moov.Metric) {
+		metrics = append(metrics, m)
+	}))
+
+	_, err := mc.CallHttp(BgCtx(), moov.Endpoint(http.MethodPost, "/accounts/%s/transfers", "account-id"),
+		moov.AcceptJson(),
+		moov.WithOperationName("checkout-charge"))
+	require.NoError(t, err)
+
+	require.Len(t, metrics, 1)
+	require.Equal(t, "checkout-charge", metrics[0].Operation)
+	require.Equal(t, "/accounts/%s/transfers", metrics[0].PathTemplate)
+	require.Contains(t, trace.String(), "# checkout-charge\n")
+}
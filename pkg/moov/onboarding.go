@@ -0,0 +1,114 @@
+package moov
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// OnboardAccount describes everything needed to create and set up a new account in one call.
+type OnboardAccount struct {
+	Account CreateAccount
+
+	// Capabilities to request once the account is created.
+	Capabilities []CapabilityName
+
+	// Optional bank account to link to the new account.
+	BankAccount *BankAccountRequest
+	// Optional card to link to the new account.
+	Card *CreateCard
+
+	// If a step after the account is created fails, disconnect the account instead of leaving it partially set up.
+	DisconnectOnFailure bool
+}
+
+// OnboardingStep names a step of OnboardAccount.
+type OnboardingStep string
+
+// List of OnboardingStep
+const (
+	OnboardingStep_CreateAccount       OnboardingStep = "create-account"
+	OnboardingStep_RequestCapabilities OnboardingStep = "request-capabilities"
+	OnboardingStep_LinkBankAccount     OnboardingStep = "link-bank-account"
+	OnboardingStep_LinkCard            OnboardingStep = "link-card"
+)
+
+// OnboardingError reports which step of OnboardAccount failed.
+type OnboardingError struct {
+	Step OnboardingStep
+
+	// ID of the account if it was created before the failure.
+	AccountID string
+
+	// Set when the account was disconnected because of the failure.
+	Disconnected bool
+
+	Err error
+}
+
+func (e *OnboardingError) Error() string {
+	msg := fmt.Sprintf("onboarding failed at %s", e.Step)
+	if e.AccountID != "" {
+		msg += fmt.Sprintf(" for account %s", e.AccountID)
+	}
+	if e.Disconnected {
+		msg += " (account disconnected)"
+	}
+	return fmt.Sprintf("%s: %v", msg, e.Err)
+}
+
+func (e *OnboardingError) Unwrap() error {
+	return e.Err
+}
+
+// OnboardAccount creates the account, requests its capabilities, and links the bank account and card if they were
+// given. If any step fails an *OnboardingError is returned naming the step. Once the account has been created it's
+// returned alongside the error so callers can finish or clean up the remaining steps themselves.
+func (c Client) OnboardAccount(ctx context.Context, req OnboardAccount) (*Account, error) {
+	completed, started, err := c.CreateAccount(ctx, req.Account)
+	if err != nil {
+		return nil, &OnboardingError{Step: OnboardingStep_CreateAccount, Err: err}
+	}
+
+	account := completed
+	if account == nil {
+		account = started
+	}
+	if account == nil {
+		return nil, &OnboardingError{Step: OnboardingStep_CreateAccount, Err: errors.New("no account was returned")}
+	}
+
+	fail := func(step OnboardingStep, err error) (*Account, error) {
+		onboardingErr := &OnboardingError{Step: step, AccountID: account.AccountID, Err: err}
+
+		if req.DisconnectOnFailure {
+			if dErr := c.DisconnectAccount(ctx, account.AccountID); dErr != nil {
+				onboardingErr.Err = errors.Join(err, fmt.Errorf("disconnecting account: %w", dErr))
+			} else {
+				onboardingErr.Disconnected = true
+			}
+		}
+
+		return account, onboardingErr
+	}
+
+	if len(req.Capabilities) > 0 {
+		if _, err := c.RequestCapabilities(ctx, account.AccountID, req.Capabilities); err != nil {
+			return fail(OnboardingStep_RequestCapabilities, err)
+		}
+	}
+
+	if req.BankAccount != nil {
+		if _, err := c.CreateBankAccount(ctx, account.AccountID, WithBankAccount(*req.BankAccount), WaitForPaymentMethod()); err != nil {
+			return fail(OnboardingStep_LinkBankAccount, err)
+		}
+	}
+
+	if req.Card != nil {
+		if _, err := c.CreateCard(ctx, account.AccountID, *req.Card); err != nil {
+			return fail(OnboardingStep_LinkCard, err)
+		}
+	}
+
+	return account, nil
+}
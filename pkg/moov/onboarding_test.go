@@ -0,0 +1,76 @@
+package moov_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/moovfinancial/moov-go/pkg/moov"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_OnboardAccount_FailsMidFlow(t *testing.T) {
+	var calls []string
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+
+		switch r.Method + " " + r.URL.Path {
+		case "POST /accounts":
+			writeJson(t, w, http.StatusOK, moov.Account{AccountID: "account-id", DisplayName: "Whole Body Fitness"})
+		case "POST /accounts/account-id/capabilities":
+			writeJson(t, w, http.StatusOK, []moov.Capability{})
+		case "POST /accounts/account-id/bank-accounts":
+			writeJson(t, w, http.StatusUnprocessableEntity, map[string]string{"error": "invalid routing number"})
+		case "DELETE /accounts/account-id":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Fatalf("unexpected call %s %s", r.Method, r.URL.Path)
+		}
+	}))
+
+	req := moov.OnboardAccount{
+		Account:      createTestBusinessAccount(),
+		Capabilities: []moov.CapabilityName{moov.CapabilityName_Transfers, moov.CapabilityName_CollectFunds},
+		BankAccount: &moov.BankAccountRequest{
+			HolderName:    "Whole Body Fitness",
+			HolderType:    moov.HolderType_Business,
+			AccountType:   moov.BankAccountType_Checking,
+			AccountNumber: "0004321567000",
+			RoutingNumber: "123456789",
+		},
+		Card: &moov.CreateCard{CardNumber: "4111111111111111"},
+	}
+
+	t.Run("reports step", func(t *testing.T) {
+		calls = nil
+
+		account, err := mc.OnboardAccount(BgCtx(), req)
+		require.Equal(t, "account-id", account.AccountID)
+
+		var onboardingErr *moov.OnboardingError
+		require.ErrorAs(t, err, &onboardingErr)
+		require.Equal(t, moov.OnboardingStep_LinkBankAccount, onboardingErr.Step)
+		require.Equal(t, "account-id", onboardingErr.AccountID)
+		require.False(t, onboardingErr.Disconnected)
+		require.Equal(t, moov.StatusFailedValidation, moov.ErrorAsCallResponse(err).Status())
+
+		// card linking is never attempted
+		require.Equal(t, []string{
+			"POST /accounts",
+			"POST /accounts/account-id/capabilities",
+			"POST /accounts/account-id/bank-accounts",
+		}, calls)
+	})
+
+	t.Run("disconnects on failure", func(t *testing.T) {
+		calls = nil
+		req.DisconnectOnFailure = true
+
+		_, err := mc.OnboardAccount(BgCtx(), req)
+
+		var onboardingErr *moov.OnboardingError
+		require.ErrorAs(t, err, &onboardingErr)
+		require.Equal(t, moov.OnboardingStep_LinkBankAccount, onboardingErr.Step)
+		require.True(t, onboardingErr.Disconnected)
+		require.Equal(t, "DELETE /accounts/account-id", calls[len(calls)-1])
+	})
+}
@@ -2,7 +2,10 @@ package moov
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 )
 
 type PaymentMethodListFilter callArg
@@ -35,6 +38,30 @@ func (c Client) ListPaymentMethods(ctx context.Context, accountID string, opts .
 	return CompletedListOrError[PaymentMethod](resp)
 }
 
+// PreferredPaymentMethod returns the account's payment method of the first type in prefer it has, such as a
+// moov-wallet payment method before falling back to ach-debit-fund. If the account has several payment methods of that
+// type the first listed is returned. ErrPaymentMethodNotFound is returned when it has none of them.
+func (c Client) PreferredPaymentMethod(ctx context.Context, accountID string, prefer []PaymentMethodType) (*PaymentMethod, error) {
+	paymentMethods, err := c.ListPaymentMethods(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, paymentMethodType := range prefer {
+		for _, pm := range paymentMethods {
+			if pm.PaymentMethodType == paymentMethodType {
+				return &pm, nil
+			}
+		}
+	}
+
+	types := make([]string, len(prefer))
+	for i, paymentMethodType := range prefer {
+		types[i] = string(paymentMethodType)
+	}
+	return nil, fmt.Errorf("%w: account %s has none of %s", ErrPaymentMethodNotFound, accountID, strings.Join(types, ", "))
+}
+
 // GetPaymentMethod retrieves a payment method for the given payment method id
 // https://docs.moov.io/api/index.html#tag/Payment-methods/operation/getPaymentMethod
 func (c Client) GetPaymentMethod(ctx context.Context, accountID string, paymentMethodID string) (*PaymentMethod, error) {
@@ -47,3 +74,28 @@ func (c Client) GetPaymentMethod(ctx context.Context, accountID string, paymentM
 
 	return CompletedObjectOrError[PaymentMethod](resp)
 }
+
+// FindEnabledPaymentMethod returns the payment method of the given type for a source so it can be used in transfers.
+// Moov creates payment methods itself once the source and the account's capabilities allow it, there's no call to
+// create or enable one. ErrPaymentMethodNotEnabled is returned until then, such as while a bank account is still
+// unverified.
+func (c Client) FindEnabledPaymentMethod(ctx context.Context, accountID string, req PaymentMethodSource) (*PaymentMethod, error) {
+	if req.SourceID == "" || req.PaymentMethodType == "" {
+		return nil, errors.New("a source ID and payment method type are required")
+	}
+
+	paymentMethods, err := c.ListPaymentMethods(ctx, accountID,
+		WithPaymentMethodSourceID(req.SourceID),
+		WithPaymentMethodType(string(req.PaymentMethodType)))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pm := range paymentMethods {
+		if pm.PaymentMethodType == req.PaymentMethodType {
+			return &pm, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: no %s payment method for source %s", ErrPaymentMethodNotEnabled, req.PaymentMethodType, req.SourceID)
+}
@@ -10,6 +10,14 @@ type PaymentMethod struct {
 	ApplePay          *ApplePayPaymentMethod    `json:"applePay,omitempty"`
 }
 
+// PaymentMethodSource identifies the payment method to use from a source, such as the ach-debit-collect method of a bank
+// account or the moov-wallet method of a wallet.
+type PaymentMethodSource struct {
+	// ID of the bank account, card, or wallet the payment method draws on.
+	SourceID          string
+	PaymentMethodType PaymentMethodType
+}
+
 // BasicPaymentMethod struct for BasicPaymentMethod
 type BasicPaymentMethod struct {
 	PaymentMethodID   string            `json:"paymentMethodID,omitempty"`
@@ -33,6 +41,22 @@ const (
 	PaymentMethodType_PullFromCard      PaymentMethodType = "pull-from-card"
 )
 
+// Rail returns the rail payment methods of this type move money over
+func (t PaymentMethodType) Rail() Rail {
+	switch t {
+	case PaymentMethodType_MoovWallet:
+		return RailWallet
+	case PaymentMethodType_AchDebitFund, PaymentMethodType_AchDebitCollect, PaymentMethodType_AchCreditStandard, PaymentMethodType_AchCreditSameDay:
+		return RailAch
+	case PaymentMethodType_RtpCredit:
+		return RailRtp
+	case PaymentMethodType_CardPayment, PaymentMethodType_ApplePay, PaymentMethodType_PushToCard, PaymentMethodType_PullFromCard:
+		return RailCard
+	default:
+		return ""
+	}
+}
+
 // WalletPaymentMethod A Moov wallet to store funds for transfers.
 type WalletPaymentMethod struct {
 	WalletID string `json:"walletID,omitempty"`
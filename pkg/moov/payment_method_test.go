@@ -1,6 +1,7 @@
 package moov_test
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/moovfinancial/moov-go/pkg/moov"
@@ -61,3 +62,64 @@ func Test_PaymentMethods(t *testing.T) {
 		require.NotNil(t, cap)
 	})
 }
+
+func Test_FindEnabledPaymentMethod(t *testing.T) {
+	verified := false
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "/accounts/account-id/payment-methods", r.URL.Path)
+		require.Equal(t, "bank-account-id", r.URL.Query().Get("sourceID"))
+		require.Equal(t, "ach-debit-collect", r.URL.Query().Get("paymentMethodType"))
+
+		if !verified {
+			writeJson(t, w, http.StatusOK, []moov.PaymentMethod{})
+			return
+		}
+		writeJson(t, w, http.StatusOK, []moov.PaymentMethod{
+			{PaymentMethodID: "payment-method-id", PaymentMethodType: moov.PaymentMethodType_AchDebitCollect},
+		})
+	}))
+
+	req := moov.PaymentMethodSource{
+		SourceID:          "bank-account-id",
+		PaymentMethodType: moov.PaymentMethodType_AchDebitCollect,
+	}
+
+	_, err := mc.FindEnabledPaymentMethod(BgCtx(), "account-id", req)
+	require.ErrorIs(t, err, moov.ErrPaymentMethodNotEnabled)
+
+	verified = true
+	pm, err := mc.FindEnabledPaymentMethod(BgCtx(), "account-id", req)
+	require.NoError(t, err)
+	require.Equal(t, "payment-method-id", pm.PaymentMethodID)
+}
+
+func Test_PreferredPaymentMethod(t *testing.T) {
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/accounts/account-id/payment-methods", r.URL.Path)
+
+		writeJson(t, w, http.StatusOK, []moov.PaymentMethod{
+			{PaymentMethodID: "card", PaymentMethodType: moov.PaymentMethodType_CardPayment},
+			{PaymentMethodID: "ach-fund", PaymentMethodType: moov.PaymentMethodType_AchDebitFund},
+			{PaymentMethodID: "wallet", PaymentMethodType: moov.PaymentMethodType_MoovWallet},
+			{PaymentMethodID: "ach-collect", PaymentMethodType: moov.PaymentMethodType_AchDebitCollect},
+		})
+	}))
+
+	pm, err := mc.PreferredPaymentMethod(BgCtx(), "account-id", []moov.PaymentMethodType{
+		moov.PaymentMethodType_MoovWallet,
+		moov.PaymentMethodType_AchDebitFund,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "wallet", pm.PaymentMethodID)
+
+	pm, err = mc.PreferredPaymentMethod(BgCtx(), "account-id", []moov.PaymentMethodType{
+		moov.PaymentMethodType_RtpCredit,
+		moov.PaymentMethodType_AchDebitFund,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "ach-fund", pm.PaymentMethodID)
+
+	_, err = mc.PreferredPaymentMethod(BgCtx(), "account-id", []moov.PaymentMethodType{moov.PaymentMethodType_RtpCredit})
+	require.ErrorIs(t, err, moov.ErrPaymentMethodNotFound)
+}
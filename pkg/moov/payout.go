@@ -0,0 +1,123 @@
+package moov
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// PayoutRequest describes moving funds out of an account's wallet to one of its bank accounts
+type PayoutRequest struct {
+	// Account the transfer is created under, usually the platform account facilitating the payout
+	PartnerAccountID string
+	// Account being paid out, which owns both the wallet and the bank account
+	AccountID string
+	// The account's moov-wallet payment method. Looked up from AccountID when not set.
+	SourcePaymentMethodID string
+	// Bank account receiving the funds
+	BankAccountID string
+	// How the bank account is credited, one of ach-credit-standard, ach-credit-same-day, or rtp-credit. Defaults to
+	// ach-credit-standard.
+	PaymentMethodType PaymentMethodType
+	// Left without a currency, the client's WithDefaultCurrency is used
+	Amount Amount
+	// Optional override of the company entry description on the bank statement for ACH payouts
+	CompanyEntryDescription string
+	Description             string
+	Metadata                map[string]string
+	// Wait for the rail to respond before returning, otherwise only the started transfer is returned
+	WaitForRailResponse bool
+}
+
+var payoutPaymentMethodTypes = []PaymentMethodType{
+	PaymentMethodType_AchCreditStandard,
+	PaymentMethodType_AchCreditSameDay,
+	PaymentMethodType_RtpCredit,
+}
+
+// Payout creates a transfer from an account's wallet to one of its bank accounts. Like CreateTransfer only one of the
+// returned transfers is set, the full transfer when waiting for the rail's response and it responds in time, otherwise
+// the started transfer.
+func (c Client) Payout(ctx context.Context, req PayoutRequest) (*Transfer, *TransferStarted, error) {
+	if req.AccountID == "" {
+		return nil, nil, errors.New("account is required")
+	}
+	if req.BankAccountID == "" {
+		return nil, nil, errors.New("destination bank account is required")
+	}
+
+	paymentMethodType := req.PaymentMethodType
+	if paymentMethodType == "" {
+		paymentMethodType = PaymentMethodType_AchCreditStandard
+	}
+	if !slices.Contains(payoutPaymentMethodTypes, paymentMethodType) {
+		return nil, nil, fmt.Errorf("payouts can't be made with %s payment methods", paymentMethodType)
+	}
+
+	paymentMethods, err := c.ListPaymentMethods(ctx, req.AccountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	source, err := payoutSource(paymentMethods, req.SourcePaymentMethodID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	i := slices.IndexFunc(paymentMethods, func(pm PaymentMethod) bool {
+		return pm.PaymentMethodType == paymentMethodType && pm.BankAccount != nil && pm.BankAccount.BankAccountID == req.BankAccountID
+	})
+	if i < 0 {
+		return nil, nil, fmt.Errorf("%w: bank account %s has no %s", ErrPaymentMethodNotEnabled, req.BankAccountID, paymentMethodType)
+	}
+
+	transfer := CreateTransfer{
+		Source: CreateTransfer_Source{
+			PaymentMethodID: source,
+		},
+		Destination: CreateTransfer_Destination{
+			PaymentMethodID: paymentMethods[i].PaymentMethodID,
+		},
+		Amount:      req.Amount,
+		Description: req.Description,
+		Metadata:    req.Metadata,
+	}
+	if req.CompanyEntryDescription != "" && paymentMethodType.Rail() == RailAch {
+		transfer.Destination.AchDetails = &CreateTransfer_AchDetailsBase{
+			CompanyEntryDescription: req.CompanyEntryDescription,
+		}
+	}
+
+	created := c.CreateTransfer(ctx, req.PartnerAccountID, transfer)
+	if req.WaitForRailResponse {
+		return created.WaitForRailResponse()
+	}
+
+	started, err := created.Started()
+	return nil, started, err
+}
+
+// payoutSource returns the wallet payment method funds are paid out from, checking a given one is a wallet
+func payoutSource(paymentMethods []PaymentMethod, paymentMethodID string) (string, error) {
+	if paymentMethodID == "" {
+		i := slices.IndexFunc(paymentMethods, func(pm PaymentMethod) bool {
+			return pm.PaymentMethodType == PaymentMethodType_MoovWallet
+		})
+		if i < 0 {
+			return "", fmt.Errorf("%w: account has no %s", ErrPaymentMethodNotEnabled, PaymentMethodType_MoovWallet)
+		}
+		return paymentMethods[i].PaymentMethodID, nil
+	}
+
+	i := slices.IndexFunc(paymentMethods, func(pm PaymentMethod) bool {
+		return pm.PaymentMethodID == paymentMethodID
+	})
+	if i < 0 {
+		return "", fmt.Errorf("%w: payment method %s", ErrNotFound, paymentMethodID)
+	}
+	if t := paymentMethods[i].PaymentMethodType; t != PaymentMethodType_MoovWallet {
+		return "", fmt.Errorf("payout source %s is a %s payment method, not a %s", paymentMethodID, t, PaymentMethodType_MoovWallet)
+	}
+	return paymentMethodID, nil
+}
@@ -3,6 +3,7 @@ package moov
 import (
 	"context"
 	"net/http"
+	"time"
 )
 
 // Ping calls the ping endpoint to make sure we have valid credentials
@@ -19,3 +20,13 @@ func (c Client) Ping(ctx context.Context) error {
 		return resp
 	}
 }
+
+// PingLatency times a ping round trip to Moov, including establishing a connection if the HTTP client has no idle one
+// to reuse. Any error from Ping is returned instead of a duration.
+func (c Client) PingLatency(ctx context.Context) (time.Duration, error) {
+	start := time.Now()
+	if err := c.Ping(ctx); err != nil {
+		return 0, err
+	}
+	return time.Since(start), nil
+}
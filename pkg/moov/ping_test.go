@@ -1,7 +1,11 @@
 package moov_test
 
 import (
+	"net/http"
 	"testing"
+
+	"github.com/moovfinancial/moov-go/pkg/moov"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_Ping(t *testing.T) {
@@ -9,3 +13,20 @@ func Test_Ping(t *testing.T) {
 	err := mc.Ping(BgCtx())
 	NoResponseError(t, err)
 }
+
+func Test_PingLatency(t *testing.T) {
+	status := http.StatusOK
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/ping", r.URL.Path)
+		w.WriteHeader(status)
+	}))
+
+	latency, err := mc.PingLatency(BgCtx())
+	require.NoError(t, err)
+	require.Positive(t, latency)
+
+	status = http.StatusUnauthorized
+	latency, err = mc.PingLatency(BgCtx())
+	require.Equal(t, moov.StatusUnauthenticated, moov.ErrorAsCallResponse(err).Status())
+	require.Zero(t, latency)
+}
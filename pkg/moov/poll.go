@@ -0,0 +1,32 @@
+package moov
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultPollInterval is how long the WaitFor* helpers wait between checks when no interval is given.
+const DefaultPollInterval = 2 * time.Second
+
+// poll calls check until it reports done, returns an error, or the context ends. The first check happens immediately.
+func poll[A any](ctx context.Context, interval time.Duration, check func(ctx context.Context) (*A, bool, error)) (*A, error) {
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		item, done, err := check(ctx)
+		if err != nil || done {
+			return item, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return item, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
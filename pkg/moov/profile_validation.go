@@ -0,0 +1,197 @@
+package moov
+
+import (
+	"fmt"
+	"net/mail"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// FieldErrors maps the JSON path of each invalid field, such as "profile.individual.email", to what's wrong with it.
+// It follows the shape of the field errors Moov returns when it fails to validate a request.
+type FieldErrors map[string]string
+
+func (e FieldErrors) Error() string {
+	fields := make([]string, 0, len(e))
+	for field := range e {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	msgs := make([]string, len(fields))
+	for i, field := range fields {
+		msgs[i] = fmt.Sprintf("%s: %s", field, e[field])
+	}
+	return "invalid fields - " + strings.Join(msgs, "; ")
+}
+
+// ProfileValidationOption changes how CreateProfile.Validate and PatchProfile.Validate check a profile. Passed to
+// CreateAccount and PatchAccount with WithProfileValidation.
+type ProfileValidationOption func(v *profileValidation)
+type profileValidation struct {
+	defaultCountryCode string
+	errs               FieldErrors
+}
+
+// WithDefaultPhoneCountryCode sets the country calling code used for phone numbers without one. Defaults to "1".
+func WithDefaultPhoneCountryCode(countryCode string) ProfileValidationOption {
+	return func(v *profileValidation) {
+		v.defaultCountryCode = countryCode
+	}
+}
+
+func newProfileValidation(opts []ProfileValidationOption) *profileValidation {
+	return applyOptions(&profileValidation{
+		defaultCountryCode: "1",
+		errs:               FieldErrors{},
+	}, opts)
+}
+
+func (v *profileValidation) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+// Validate checks the profile's email, phone, and address for mistakes Moov's verification would reject, and normalizes
+// phone numbers and address codes. Normalized values replace the profile's pointers rather than editing the values they
+// point at. A FieldErrors is returned listing every invalid field.
+func (p *CreateProfile) Validate(opts ...ProfileValidationOption) error {
+	v := newProfileValidation(opts)
+	validateProfilePart(v, "profile.individual", &p.Individual)
+	validateProfilePart(v, "profile.business", &p.Business)
+	return v.err()
+}
+
+// Validate checks and normalizes the profile the same as CreateProfile.Validate.
+func (p *PatchProfile) Validate(opts ...ProfileValidationOption) error {
+	v := newProfileValidation(opts)
+	validateProfilePart(v, "profile.individual", &p.Individual)
+	validateProfilePart(v, "profile.business", &p.Business)
+	return v.err()
+}
+
+// profilePart is the individual or business part of a profile being created or patched, giving access to the contact
+// details that are checked.
+type profilePart interface {
+	contact() (email string, phone **Phone, address **Address)
+}
+
+func (p *CreateIndividualProfile) contact() (string, **Phone, **Address) {
+	return p.Email, &p.Phone, &p.Address
+}
+
+func (p *CreateBusinessProfile) contact() (string, **Phone, **Address) {
+	return p.Email, &p.Phone, &p.Address
+}
+
+func (p *PatchIndividualProfile) contact() (string, **Phone, **Address) {
+	return p.Email, &p.Phone, &p.Address
+}
+
+func (p *PatchBusinessProfile) contact() (string, **Phone, **Address) {
+	return p.Email, &p.Phone, &p.Address
+}
+
+// validateProfilePart checks a copy of the part, if it's set, and replaces the part with the normalized copy.
+func validateProfilePart[T interface{}, P interface {
+	*T
+	profilePart
+}](v *profileValidation, field string, part **T) {
+	if *part == nil {
+		return
+	}
+
+	normalized := **part
+	email, phone, address := P(&normalized).contact()
+	v.email(field+".email", email)
+	*phone = v.phone(field+".phone", *phone)
+	*address = v.address(field+".address", *address)
+	*part = &normalized
+}
+
+func (v *profileValidation) email(field, email string) {
+	if email == "" {
+		return
+	}
+
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		v.errs[field] = fmt.Sprintf("%q is not a valid email address", email)
+	}
+}
+
+var nonDigits = regexp.MustCompile(`\D`)
+
+// phone returns a copy of the phone with its country code and number reduced to digits, the E.164 parts Moov expects.
+func (v *profileValidation) phone(field string, phone *Phone) *Phone {
+	if phone == nil {
+		return nil
+	}
+
+	normalized := Phone{
+		CountryCode: nonDigits.ReplaceAllString(phone.CountryCode, ""),
+		Number:      nonDigits.ReplaceAllString(phone.Number, ""),
+	}
+	if normalized.CountryCode == "" {
+		normalized.CountryCode = v.defaultCountryCode
+	}
+
+	// North American numbers are often written with the leading 1
+	if normalized.CountryCode == "1" && len(normalized.Number) == 11 && strings.HasPrefix(normalized.Number, "1") {
+		normalized.Number = normalized.Number[1:]
+	}
+
+	switch {
+	case len(normalized.CountryCode) < 1 || len(normalized.CountryCode) > 3:
+		v.errs[field+".countryCode"] = fmt.Sprintf("%q is not a valid country calling code", phone.CountryCode)
+	case normalized.CountryCode == "1" && len(normalized.Number) != 10:
+		v.errs[field+".number"] = fmt.Sprintf("%q must be 10 digits", phone.Number)
+	case len(normalized.Number) < 4 || len(normalized.CountryCode)+len(normalized.Number) > 15:
+		v.errs[field+".number"] = fmt.Sprintf("%q is not a valid phone number", phone.Number)
+	}
+
+	return &normalized
+}
+
+var (
+	usZipCode       = regexp.MustCompile(`^\d{5}(-\d{4})?$`)
+	caPostalCode    = regexp.MustCompile(`^[A-Z]\d[A-Z] ?\d[A-Z]\d$`)
+	usStateCodes    = []string{"AL", "AK", "AZ", "AR", "CA", "CO", "CT", "DE", "DC", "FL", "GA", "HI", "ID", "IL", "IN", "IA", "KS", "KY", "LA", "ME", "MD", "MA", "MI", "MN", "MS", "MO", "MT", "NE", "NV", "NH", "NJ", "NM", "NY", "NC", "ND", "OH", "OK", "OR", "PA", "RI", "SC", "SD", "TN", "TX", "UT", "VT", "VA", "WA", "WV", "WI", "WY", "AS", "GU", "MP", "PR", "VI"}
+	caProvinceCodes = []string{"AB", "BC", "MB", "NB", "NL", "NS", "NT", "NU", "ON", "PE", "QC", "SK", "YT"}
+)
+
+// address returns a copy of the address with its codes uppercased. Only US and CA addresses have their state and postal
+// codes checked.
+func (v *profileValidation) address(field string, address *Address) *Address {
+	if address == nil {
+		return nil
+	}
+
+	normalized := *address
+	normalized.StateOrProvince = strings.ToUpper(strings.TrimSpace(address.StateOrProvince))
+	normalized.PostalCode = strings.ToUpper(strings.TrimSpace(address.PostalCode))
+	normalized.Country = strings.ToUpper(strings.TrimSpace(address.Country))
+
+	switch normalized.Country {
+	case "US":
+		if !slices.Contains(usStateCodes, normalized.StateOrProvince) {
+			v.errs[field+".stateOrProvince"] = fmt.Sprintf("%q is not a US state code", address.StateOrProvince)
+		}
+		if !usZipCode.MatchString(normalized.PostalCode) {
+			v.errs[field+".postalCode"] = fmt.Sprintf("%q is not a US ZIP code", address.PostalCode)
+		}
+	case "CA":
+		if !slices.Contains(caProvinceCodes, normalized.StateOrProvince) {
+			v.errs[field+".stateOrProvince"] = fmt.Sprintf("%q is not a Canadian province code", address.StateOrProvince)
+		}
+		if !caPostalCode.MatchString(normalized.PostalCode) {
+			v.errs[field+".postalCode"] = fmt.Sprintf("%q is not a Canadian postal code", address.PostalCode)
+		}
+	}
+
+	return &normalized
+}
@@ -0,0 +1,122 @@
+package moov_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moovfinancial/moov-go/pkg/moov"
+)
+
+func Test_CreateProfile_Validate(t *testing.T) {
+	valid := func() moov.CreateProfile {
+		return moov.CreateProfile{
+			Individual: &moov.CreateIndividualProfile{
+				Email: "noreply@moov.io",
+				Phone: &moov.Phone{Number: "+1 (555) 555-5555"},
+				Address: &moov.Address{
+					AddressLine1:    "123 Main St",
+					City:            "Moov City",
+					StateOrProvince: "co",
+					PostalCode:      "80301",
+					Country:         "us",
+				},
+			},
+		}
+	}
+
+	t.Run("normalized", func(t *testing.T) {
+		original := valid()
+		profile := original
+		require.NoError(t, profile.Validate())
+
+		require.Equal(t, &moov.Phone{CountryCode: "1", Number: "5555555555"}, profile.Individual.Phone)
+		require.Equal(t, "CO", profile.Individual.Address.StateOrProvince)
+		require.Equal(t, "US", profile.Individual.Address.Country)
+
+		// The caller's values aren't modified
+		require.Equal(t, "+1 (555) 555-5555", original.Individual.Phone.Number)
+	})
+
+	t.Run("default country code", func(t *testing.T) {
+		profile := valid()
+		profile.Individual.Phone = &moov.Phone{Number: "020 7946 0958"}
+		require.NoError(t, profile.Validate(moov.WithDefaultPhoneCountryCode("44")))
+		require.Equal(t, &moov.Phone{CountryCode: "44", Number: "02079460958"}, profile.Individual.Phone)
+	})
+
+	cases := []struct {
+		name   string
+		modify func(p *moov.CreateProfile)
+		field  string
+	}{
+		{"email missing domain", func(p *moov.CreateProfile) { p.Individual.Email = "noreply@" }, "profile.individual.email"},
+		{"email with name", func(p *moov.CreateProfile) { p.Individual.Email = "Moov <noreply@moov.io>" }, "profile.individual.email"},
+		{"short phone", func(p *moov.CreateProfile) { p.Individual.Phone.Number = "555-5555" }, "profile.individual.phone.number"},
+		{"bad state", func(p *moov.CreateProfile) { p.Individual.Address.StateOrProvince = "Colorado" }, "profile.individual.address.stateOrProvince"},
+		{"bad zip", func(p *moov.CreateProfile) { p.Individual.Address.PostalCode = "8030" }, "profile.individual.address.postalCode"},
+		{"bad postal code", func(p *moov.CreateProfile) {
+			p.Individual.Address.Country = "CA"
+			p.Individual.Address.StateOrProvince = "ON"
+			p.Individual.Address.PostalCode = "80301"
+		}, "profile.individual.address.postalCode"},
+		{"business email", func(p *moov.CreateProfile) {
+			p.Business = &moov.CreateBusinessProfile{Name: "Moov", Email: "not an email"}
+		}, "profile.business.email"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			profile := valid()
+			tc.modify(&profile)
+
+			err := profile.Validate()
+
+			var fieldErrs moov.FieldErrors
+			require.ErrorAs(t, err, &fieldErrs)
+			require.Len(t, fieldErrs, 1)
+			require.Contains(t, fieldErrs, tc.field)
+		})
+	}
+}
+
+func Test_CreateAccount_ValidatesProfile(t *testing.T) {
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("invalid profile was sent to %s", r.URL.Path)
+	}))
+
+	_, _, err := mc.CreateAccount(BgCtx(), moov.CreateAccount{
+		Type: moov.AccountType_Individual,
+		Profile: moov.CreateProfile{
+			Individual: &moov.CreateIndividualProfile{Email: "noreply"},
+		},
+	})
+
+	var fieldErrs moov.FieldErrors
+	require.ErrorAs(t, err, &fieldErrs)
+	require.Contains(t, fieldErrs, "profile.individual.email")
+}
+
+func Test_CreateAccount_ProfileValidationOptions(t *testing.T) {
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var got moov.CreateAccount
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("decoding account: %v", err)
+			return
+		}
+		if phone := got.Profile.Individual.Phone; phone == nil || phone.CountryCode != "44" {
+			t.Errorf("phone wasn't normalized with the default country code: %+v", phone)
+		}
+		writeJson(t, w, http.StatusOK, moov.Account{AccountID: "account-id"})
+	}))
+
+	_, _, err := mc.CreateAccount(BgCtx(), moov.CreateAccount{
+		Type: moov.AccountType_Individual,
+		Profile: moov.CreateProfile{
+			Individual: &moov.CreateIndividualProfile{Phone: &moov.Phone{Number: "020 7946 0000"}},
+		},
+	}, moov.WithProfileValidation(moov.WithDefaultPhoneCountryCode("44")))
+	require.NoError(t, err)
+}
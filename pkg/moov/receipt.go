@@ -49,12 +49,5 @@ func (c Client) ListReceipts(ctx context.Context, filters ...ListReceiptsFilter)
 }
 
 func (c Client) DeleteReceipt(ctx context.Context, receiptID string) error {
-	resp, err := c.CallHttp(ctx,
-		Endpoint(http.MethodDelete, pathReceipt, receiptID),
-		AcceptJson())
-	if err != nil {
-		return err
-	}
-
-	return CompletedNilOrError(resp)
+	return c.delete(ctx, Endpoint(http.MethodDelete, pathReceipt, receiptID))
 }
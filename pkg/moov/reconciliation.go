@@ -0,0 +1,112 @@
+package moov
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// WalletReconciliation ties a wallet's completed transactions over a calendar day back to the transfers, refunds, and
+// fees that created them.
+type WalletReconciliation struct {
+	AccountID string
+	WalletID  string
+
+	// Start and end of the day the reconciliation covers. Start is inclusive while End is exclusive.
+	Start time.Time
+	End   time.Time
+
+	// Transactions matched to the transfer or refund that created them.
+	Matched []ReconciledTransaction
+	// Transactions for Moov's fees. Moov doesn't expose fees individually, so these can't be matched any further.
+	Fees []WalletTransaction
+	// Transactions that couldn't be matched, including those from other sources such as disputes and sweeps.
+	Unmatched []WalletTransaction
+
+	// Sum of the net amounts of every completed transaction of the day.
+	NetChange Amount
+}
+
+// ReconciledTransaction is a wallet transaction along with what created it
+type ReconciledTransaction struct {
+	Transaction WalletTransaction
+	// The transfer that created the transaction, or that the refund is of.
+	Transfer *Transfer
+	// Set when the transaction is for a refund of the transfer and the transfer has only the one refund. Transactions
+	// reference the transfer rather than the refund, so a refund of a transfer refunded more than once isn't identified.
+	Refund *Refund
+}
+
+// ReconcileWalletDay matches each transaction completed in the wallet during the calendar day containing `day` to the
+// transfer or refund that created it. Like SettlementReport the day's boundaries are taken from the location of `day`.
+func (c Client) ReconcileWalletDay(ctx context.Context, accountID, walletID string, day time.Time) (*WalletReconciliation, error) {
+	start := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+	end := start.AddDate(0, 0, 1)
+
+	recon := &WalletReconciliation{
+		AccountID: accountID,
+		WalletID:  walletID,
+		Start:     start,
+		End:       end,
+	}
+
+	completed, err := listAllPages(func(skip, count int) ([]WalletTransaction, error) {
+		return c.ListWalletTransactions(ctx, accountID, walletID,
+			WithTransactionStatus(string(WalletTransactionStatus_Completed)),
+			WithCompletedStartDateTime(start),
+			WithCompletedEndDateTime(end),
+			WithTransactionCount(count),
+			WithTransactionSkip(skip))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Transactions of a transfer, its fees, and its refunds all point at the same transfer
+	transfers := make(map[string]*Transfer)
+
+	for _, txn := range completed {
+		if txn.SourceType == WalletTransactionSourceTypeFee {
+			recon.Fees = append(recon.Fees, txn)
+			continue
+		}
+
+		transferID, ok := txn.RelatedTransferID()
+		if !ok {
+			recon.Unmatched = append(recon.Unmatched, txn)
+			continue
+		}
+
+		transfer, seen := transfers[transferID]
+		if !seen {
+			var err error
+			transfer, err = c.GetTransfer(ctx, accountID, transferID)
+			if resp := ErrorAsCallResponse(err); resp != nil && resp.Status() == StatusNotFound {
+				err = nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			transfers[transferID] = transfer
+		}
+		if transfer == nil {
+			recon.Unmatched = append(recon.Unmatched, txn)
+			continue
+		}
+
+		matched := ReconciledTransaction{Transaction: txn, Transfer: transfer}
+		if txn.TransactionType == WalletTransactionTypeRefund && len(transfer.Refunds) == 1 {
+			matched.Refund = &transfer.Refunds[0]
+		}
+		recon.Matched = append(recon.Matched, matched)
+	}
+
+	recon.NetChange, err = SumAmounts(completed, func(t WalletTransaction) Amount {
+		return Amount{Currency: t.Currency, Value: int64(t.NetAmount)}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("wallet reconciliation: %w", err)
+	}
+
+	return recon, nil
+}
@@ -0,0 +1,78 @@
+package moov_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/moovfinancial/moov-go/pkg/moov"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ReconcileWalletDay(t *testing.T) {
+	day := time.Date(2024, time.January, 10, 15, 0, 0, 0, time.UTC)
+
+	transfer := moov.Transfer{
+		TransferID: "transfer-id",
+		Status:     moov.TransferStatus_Completed,
+		Amount:     moov.Amount{Currency: "USD", Value: 1000},
+		Refunds: []moov.Refund{
+			{RefundID: "refund-id", Status: moov.RefundStatus_Completed, Amount: moov.Amount{Currency: "USD", Value: 400}},
+		},
+	}
+
+	// Refunded twice for the same amount, so its refund transactions can't be told apart
+	refundedTwice := moov.Transfer{
+		TransferID: "refunded-twice-id",
+		Status:     moov.TransferStatus_Completed,
+		Amount:     moov.Amount{Currency: "USD", Value: 1000},
+		Refunds: []moov.Refund{
+			{RefundID: "first-refund-id", Status: moov.RefundStatus_Completed, Amount: moov.Amount{Currency: "USD", Value: 100}},
+			{RefundID: "second-refund-id", Status: moov.RefundStatus_Completed, Amount: moov.Amount{Currency: "USD", Value: 100}},
+		},
+	}
+
+	transactions := []moov.WalletTransaction{
+		{TransactionID: "payment", TransactionType: moov.WalletTransactionTypePayment, SourceType: moov.WalletTransactionSourceTypeTransfer, SourceID: "transfer-id", Currency: "USD", GrossAmount: 1000, NetAmount: 1000},
+		{TransactionID: "refund", TransactionType: moov.WalletTransactionTypeRefund, SourceType: moov.WalletTransactionSourceTypeTransfer, SourceID: "transfer-id", Currency: "USD", GrossAmount: -400, NetAmount: -400},
+		{TransactionID: "refund-of-many", TransactionType: moov.WalletTransactionTypeRefund, SourceType: moov.WalletTransactionSourceTypeTransfer, SourceID: "refunded-twice-id", Currency: "USD", GrossAmount: -100, NetAmount: -100},
+		{TransactionID: "fee", TransactionType: moov.WalletTransactionTypeMoovFee, SourceType: moov.WalletTransactionSourceTypeFee, SourceID: "fee-id", Currency: "USD", GrossAmount: -15, NetAmount: -15},
+		{TransactionID: "deleted-transfer", TransactionType: moov.WalletTransactionTypePayment, SourceType: moov.WalletTransactionSourceTypeTransfer, SourceID: "missing-id", Currency: "USD", GrossAmount: 250, NetAmount: 250},
+	}
+
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/accounts/account-id/wallets/wallet-id/transactions":
+			require.Equal(t, "completed", r.URL.Query().Get("status"))
+			require.Equal(t, "2024-01-10T00:00:00Z", r.URL.Query().Get("completedStartDateTime"))
+			require.Equal(t, "2024-01-11T00:00:00Z", r.URL.Query().Get("completedEndDateTime"))
+			writeJson(t, w, http.StatusOK, transactions)
+		case "/accounts/account-id/transfers/transfer-id":
+			writeJson(t, w, http.StatusOK, transfer)
+		case "/accounts/account-id/transfers/refunded-twice-id":
+			writeJson(t, w, http.StatusOK, refundedTwice)
+		case "/accounts/account-id/transfers/missing-id":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	recon, err := mc.ReconcileWalletDay(BgCtx(), "account-id", "wallet-id", day)
+	require.NoError(t, err)
+
+	require.Len(t, recon.Matched, 3)
+	require.Equal(t, "transfer-id", recon.Matched[0].Transfer.TransferID)
+	require.Nil(t, recon.Matched[0].Refund)
+	require.Equal(t, "refund-id", recon.Matched[1].Refund.RefundID)
+	require.Equal(t, "refunded-twice-id", recon.Matched[2].Transfer.TransferID)
+	require.Nil(t, recon.Matched[2].Refund)
+
+	require.Len(t, recon.Fees, 1)
+
+	require.Len(t, recon.Unmatched, 1)
+	require.Equal(t, "deleted-transfer", recon.Unmatched[0].TransactionID)
+
+	require.Equal(t, moov.Amount{Currency: "USD", Value: 735}, recon.NetChange)
+}
@@ -65,10 +65,5 @@ func (c Client) UpdateRepresentative(ctx context.Context, representativeAccountI
 
 // DeleteRepresentative deletes a representative for the given account
 func (c Client) DeleteRepresentative(ctx context.Context, accountID string, representativeAccountID string) error {
-	resp, err := c.CallHttp(ctx, Endpoint(http.MethodDelete, pathRepresentative, accountID, representativeAccountID))
-	if err != nil {
-		return err
-	}
-
-	return CompletedNilOrError(resp)
+	return c.delete(ctx, Endpoint(http.MethodDelete, pathRepresentative, accountID, representativeAccountID))
 }
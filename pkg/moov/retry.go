@@ -0,0 +1,219 @@
+package moov
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Retries made by default once retrying is turned on with WithRetries or WithRetryPredicate.
+const (
+	DefaultRetryAttempts = 3
+	DefaultRetryBackoff  = 250 * time.Millisecond
+)
+
+type retryPolicy struct {
+	// Total attempts at a call, including the first.
+	attempts int
+	// Wait before the first retry, doubled for each retry after it.
+	backoff   time.Duration
+	jitter    JitterMode
+	predicate func(resp *http.Response, err error) bool
+
+	// Source of jitter, nil for the shared generator
+	rand *rand.Rand
+
+	// Set by WithRetries and WithRetryPredicate, other options only configure the retries
+	enabled bool
+}
+
+func (c *Client) retries() *retryPolicy {
+	if c.retry == nil {
+		c.retry = &retryPolicy{
+			attempts:  DefaultRetryAttempts,
+			backoff:   DefaultRetryBackoff,
+			jitter:    FullJitter,
+			predicate: DefaultRetryPredicate,
+		}
+	}
+	return c.retry
+}
+
+// WithRetries retries calls that fail with a transport error, or with a rate limited or server error response, making
+// up to attempts calls in total. The first retry waits for backoff and each retry after it waits twice as long as the
+// one before. Waits are randomized as set by WithRetryJitter. When a response's Retry-After header asks for a longer
+// wait it's honored, up to the longest backoff. Calls that create something are only retried when they're sent with an
+// idempotency key, so retrying can't create it twice.
+func WithRetries(attempts int, backoff time.Duration) ClientConfigurable {
+	return func(c *Client) error {
+		if attempts < 1 {
+			return fmt.Errorf("retry attempts must be at least 1, got %d", attempts)
+		}
+		if backoff < 0 {
+			return fmt.Errorf("retry backoff must not be negative, got %s", backoff)
+		}
+
+		policy := c.retries()
+		policy.attempts = attempts
+		policy.backoff = backoff
+		policy.enabled = true
+		return nil
+	}
+}
+
+// WithRetryPredicate decides which calls are retried in place of DefaultRetryPredicate, such as to also retry a 400
+// Moov returns for a transient lock. The predicate is given the response, with its body readable, or the TransportError
+// if there wasn't one. Retries use the attempts and backoff of WithRetries, or the defaults, and calls that create
+// something still aren't retried without an idempotency key.
+func WithRetryPredicate(predicate func(resp *http.Response, err error) bool) ClientConfigurable {
+	return func(c *Client) error {
+		policy := c.retries()
+		policy.predicate = predicate
+		policy.enabled = true
+		return nil
+	}
+}
+
+// JitterMode is how much of the wait between retries is randomized, so clients retrying after the same outage don't
+// all retry in lockstep.
+type JitterMode string
+
+// List of JitterMode
+const (
+	// NoJitter waits exactly the backoff.
+	NoJitter JitterMode = "none"
+	// FullJitter waits a random time between zero and the backoff. This is the default.
+	FullJitter JitterMode = "full"
+	// EqualJitter waits half the backoff plus a random time up to the other half.
+	EqualJitter JitterMode = "equal"
+)
+
+// WithRetryJitter sets how the wait between retries is randomized. Defaults to FullJitter. It doesn't turn retrying on
+// by itself, see WithRetries.
+func WithRetryJitter(mode JitterMode) ClientConfigurable {
+	return func(c *Client) error {
+		switch mode {
+		case NoJitter, FullJitter, EqualJitter:
+			c.retries().jitter = mode
+			return nil
+		default:
+			return fmt.Errorf("unknown retry jitter mode %q", mode)
+		}
+	}
+}
+
+// DefaultRetryPredicate retries transport errors and rate limited or server error responses. Custom predicates can
+// call it to extend it rather than replace it.
+func DefaultRetryPredicate(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+}
+
+// sendWithRetries sends the call until it succeeds, the retry policy gives up, or the context ends.
+func (c *Client) sendWithRetries(ctx context.Context, call *callBuilder, send func(context.Context, *callBuilder) (*httpCallResponse, error)) (*httpCallResponse, error) {
+	if c.retry == nil || !c.retry.enabled || !retryable(call) {
+		return send(ctx, call)
+	}
+
+	// Keep the body so it can be sent again on a retry
+	var body []byte
+	if call.body != nil {
+		b, err := io.ReadAll(call.body)
+		if err != nil {
+			return nil, err
+		}
+		body = b
+	}
+
+	wait := c.retry.backoff
+	for attempt := 1; ; attempt++ {
+		if body != nil {
+			call.body = bytes.NewReader(body)
+		}
+
+		resp, err := send(ctx, call)
+		if attempt >= c.retry.attempts || !c.retry.shouldRetry(resp, err) {
+			return resp, err
+		}
+
+		// Wait as long as Moov asks when it's longer, such as during maintenance, within the policy's longest backoff
+		delay := c.retry.jittered(wait)
+		if resp != nil {
+			delay = max(delay, min(retryAfter(resp.Header("Retry-After"), time.Now()), c.retry.maxBackoff()))
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return resp, err
+		case <-timer.C:
+		}
+		wait *= 2
+	}
+}
+
+// jittered randomizes the wait following the policy's JitterMode.
+func (p *retryPolicy) jittered(wait time.Duration) time.Duration {
+	if wait <= 0 {
+		return 0
+	}
+
+	random := rand.Int64N
+	if p.rand != nil {
+		random = p.rand.Int64N
+	}
+
+	switch p.jitter {
+	case FullJitter:
+		return time.Duration(random(int64(wait) + 1))
+	case EqualJitter:
+		half := wait / 2
+		return half + time.Duration(random(int64(wait-half)+1))
+	default:
+		return wait
+	}
+}
+
+// maxBackoff is the wait before the last retry, the longest the policy waits.
+func (p *retryPolicy) maxBackoff() time.Duration {
+	if p.attempts < 2 {
+		return 0
+	}
+	return p.backoff << (p.attempts - 2)
+}
+
+func (p *retryPolicy) shouldRetry(resp *httpCallResponse, err error) bool {
+	if err != nil {
+		// Only transport errors are worth retrying, others such as auth failures fail the same way again
+		var transportErr *TransportError
+		return errors.As(err, &transportErr) && p.predicate(nil, err)
+	}
+
+	// The body was already read, so hand the predicate a copy it can read again
+	r := *resp.resp
+	r.Body = io.NopCloser(bytes.NewReader(resp.body))
+	return p.predicate(&r, nil)
+}
+
+// retryAfter parses a Retry-After header given as seconds or an HTTP-date, returning zero if it's missing or invalid.
+func retryAfter(header string, now time.Time) time.Duration {
+	if header == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(header); err == nil && secs > 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(header); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
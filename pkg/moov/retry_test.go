@@ -0,0 +1,132 @@
+package moov_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/moovfinancial/moov-go/pkg/moov"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRetryPredicate(t *testing.T) {
+	requests := map[string]int{}
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.Method]++
+
+		// Locked on the first attempt of every call
+		if requests[r.Method] == 1 {
+			writeJson(t, w, http.StatusBadRequest, map[string]string{"code": "resource-locked"})
+			return
+		}
+		writeJson(t, w, http.StatusOK, map[string]string{})
+	}), moov.WithRetries(3, 0), moov.WithRetryPredicate(func(resp *http.Response, err error) bool {
+		if moov.DefaultRetryPredicate(resp, err) {
+			return true
+		}
+
+		var body struct{ Code string }
+		return resp.StatusCode == http.StatusBadRequest && json.NewDecoder(resp.Body).Decode(&body) == nil && body.Code == "resource-locked"
+	}))
+
+	resp, err := mc.CallHttp(BgCtx(), moov.Endpoint(http.MethodGet, "/accounts"), moov.AcceptJson())
+	require.NoError(t, err)
+	require.Equal(t, moov.StatusCompleted, resp.Status())
+	require.Equal(t, 2, requests[http.MethodGet])
+
+	t.Run("creates without an idempotency key aren't retried", func(t *testing.T) {
+		resp, err := mc.CallHttp(BgCtx(), moov.Endpoint(http.MethodPost, "/accounts"), moov.AcceptJson(), moov.JsonBody(map[string]string{}))
+		require.NoError(t, err)
+		require.Equal(t, moov.StatusBadRequest, resp.Status())
+		require.Equal(t, 1, requests[http.MethodPost])
+	})
+
+	t.Run("keyed creates are retried with the same body", func(t *testing.T) {
+		var bodies []string
+		mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			b, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			bodies = append(bodies, string(b))
+
+			status := http.StatusServiceUnavailable
+			if len(bodies) == 2 {
+				status = http.StatusOK
+			}
+			writeJson(t, w, status, map[string]string{})
+		}), moov.WithRetries(3, 0))
+
+		resp, err := mc.CallHttp(BgCtx(), moov.Endpoint(http.MethodPost, "/accounts"),
+			moov.AcceptJson(),
+			moov.IdempotencyKey("key"),
+			moov.JsonBody(map[string]string{"foreignID": "user-1"}))
+		require.NoError(t, err)
+		require.Equal(t, moov.StatusCompleted, resp.Status())
+		require.Len(t, bodies, 2)
+		require.Equal(t, bodies[0], bodies[1])
+	})
+}
+
+func TestMaintenanceError(t *testing.T) {
+	maintenance := true
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "120")
+		if maintenance {
+			writeJson(t, w, http.StatusServiceUnavailable, map[string]string{"error": "Moov is undergoing scheduled maintenance"})
+			return
+		}
+		writeJson(t, w, http.StatusServiceUnavailable, map[string]string{"error": "upstream unavailable"})
+	}))
+
+	_, err := mc.GetAccount(BgCtx(), "account-id")
+
+	var maintenanceErr *moov.MaintenanceError
+	require.ErrorAs(t, err, &maintenanceErr)
+	require.Equal(t, "Moov is undergoing scheduled maintenance", maintenanceErr.Message)
+	require.Equal(t, 2*time.Minute, maintenanceErr.RetryAfter)
+	require.Equal(t, http.StatusServiceUnavailable, moov.ErrorAsHttpCallResponse(err).StatusCode())
+
+	t.Run("other 503s", func(t *testing.T) {
+		maintenance = false
+
+		_, err := mc.GetAccount(BgCtx(), "account-id")
+		require.False(t, errors.As(err, &maintenanceErr))
+		require.Equal(t, moov.StatusServerError, moov.ErrorAsCallResponse(err).Status())
+	})
+}
+
+func TestWithRetries_HonorsRetryAfter(t *testing.T) {
+	requests := 0
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "1")
+			writeJson(t, w, http.StatusServiceUnavailable, map[string]string{"error": "down for maintenance"})
+			return
+		}
+		writeJson(t, w, http.StatusOK, moov.Account{AccountID: "account-id"})
+	}), moov.WithRetries(2, time.Second), moov.WithRetryJitter(moov.FullJitter))
+
+	start := time.Now()
+	account, err := mc.GetAccount(BgCtx(), "account-id")
+	require.NoError(t, err)
+	require.Equal(t, "account-id", account.AccountID)
+	require.GreaterOrEqual(t, time.Since(start), time.Second)
+
+	t.Run("capped at the longest backoff", func(t *testing.T) {
+		requests := 0
+		mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.Header().Set("Retry-After", "120")
+			writeJson(t, w, http.StatusServiceUnavailable, map[string]string{"error": "down for maintenance"})
+		}), moov.WithRetries(3, 10*time.Millisecond))
+
+		start := time.Now()
+		_, err := mc.GetAccount(BgCtx(), "account-id")
+		require.Error(t, err)
+		require.Equal(t, 3, requests)
+		require.Less(t, time.Since(start), time.Second)
+	})
+}
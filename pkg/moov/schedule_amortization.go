@@ -0,0 +1,159 @@
+package moov
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// LoanAccounts are the payment methods a loan's monthly payments are pulled from and paid to.
+type LoanAccounts struct {
+	// Borrower's payment method the payments are pulled from
+	Source SchedulePaymentMethod
+	// Lender's payment method the payments are paid to
+	Destination SchedulePaymentMethod
+
+	// Set when the payments are made on behalf of a partner
+	PartnerAccountID string
+}
+
+// AmortizedPayment is one month's payment of an amortized loan, with amounts in the currency's minor units.
+type AmortizedPayment struct {
+	// Position of the payment in the term, starting at 1
+	PaymentNumber int
+	RunOn         time.Time
+
+	// Payment is the Principal and Interest together
+	Payment   int64
+	Principal int64
+	Interest  int64
+	// Principal left to pay after this payment
+	Balance int64
+}
+
+// Amortize splits repaying the principal at the annual interest rate into equal monthly payments over the term,
+// starting on the start date. Interest accrues monthly on the remaining balance and is rounded to the currency's minor
+// units, with the final payment adjusted so the principal is paid off exactly. Payments due on a day the month doesn't
+// have run on the last day of that month.
+func Amortize(principal Amount, annualRatePct float64, termMonths int, start time.Time) ([]AmortizedPayment, error) {
+	switch {
+	case principal.Value <= 0:
+		return nil, fmt.Errorf("%w: principal %d must be positive", ErrInvalidAmount, principal.Value)
+	case annualRatePct < 0 || math.IsNaN(annualRatePct) || math.IsInf(annualRatePct, 0):
+		return nil, fmt.Errorf("annual rate %v must not be negative", annualRatePct)
+	case termMonths <= 0:
+		return nil, fmt.Errorf("term of %d months must be at least one month", termMonths)
+	case principal.Value < int64(termMonths):
+		return nil, fmt.Errorf("%w: principal %d is too small to split into %d monthly payments", ErrInvalidAmount, principal.Value, termMonths)
+	}
+
+	rate := annualRatePct / 100 / 12
+
+	payment := principal.Value / int64(termMonths)
+	if rate > 0 {
+		payment = int64(math.Round(rate * float64(principal.Value) / (1 - math.Pow(1+rate, -float64(termMonths)))))
+	}
+
+	payments := make([]AmortizedPayment, termMonths)
+	balance := principal.Value
+	for i := range payments {
+		interest := int64(math.Round(float64(balance) * rate))
+
+		p := AmortizedPayment{
+			PaymentNumber: i + 1,
+			RunOn:         addMonths(start, i),
+			Interest:      interest,
+			Principal:     payment - interest,
+		}
+
+		// Rounding leaves a few cents over or under, so the last payment pays off whatever's left
+		if i == termMonths-1 || p.Principal > balance {
+			p.Principal = balance
+		}
+		if p.Principal < 0 {
+			return nil, fmt.Errorf("monthly payment of %d doesn't cover the %d of interest", payment, interest)
+		}
+
+		p.Payment = p.Principal + p.Interest
+		balance -= p.Principal
+		p.Balance = balance
+		payments[i] = p
+	}
+
+	return payments, checkAmortization(principal.Value, rate, payments)
+}
+
+// checkAmortization makes sure every payment is for something, the principal is paid off, and the payments total what
+// the annuity formula gives for the loan. Rounding the payment and each month's interest moves the balance by up to a
+// minor unit a month, which then accrues interest of its own, so the total is allowed to be off by that much.
+func checkAmortization(principal int64, rate float64, payments []AmortizedPayment) error {
+	var paid, total int64
+	for _, p := range payments {
+		if p.Payment <= 0 {
+			return fmt.Errorf("%w: rounding the monthly payment pays the loan off before payment %d of %d", ErrInvalidAmount, p.PaymentNumber, len(payments))
+		}
+		paid += p.Principal
+		total += p.Payment
+	}
+
+	if paid != principal {
+		return fmt.Errorf("amortized payments repay %d of the %d principal", paid, principal)
+	}
+
+	n := float64(len(payments))
+	want, tolerance := float64(principal), n
+	if rate > 0 {
+		want = n * rate * float64(principal) / (1 - math.Pow(1+rate, -n))
+		tolerance += (math.Pow(1+rate, n) - 1) / rate
+	}
+	if math.Abs(float64(total)-want) > tolerance {
+		return fmt.Errorf("amortized payments total %d but the loan should cost %.0f", total, want)
+	}
+	return nil
+}
+
+// BuildAmortizedSchedule returns a schedule with an occurrence for each monthly payment Amortize works out, ready to
+// pass to CreateSchedule. The principal's currency code is uppercased as Moov expects. Moov transfers a single amount, so
+// each occurrence's description records its split between principal and interest.
+func BuildAmortizedSchedule(principal Amount, annualRatePct float64, termMonths int, start time.Time, accounts LoanAccounts) (CreateSchedule, error) {
+	principal.Currency = strings.ToUpper(strings.TrimSpace(principal.Currency))
+	if err := (ScheduleAmount{Currency: principal.Currency, Value: principal.Value}).Validate(); err != nil {
+		return CreateSchedule{}, err
+	}
+
+	payments, err := Amortize(principal, annualRatePct, termMonths, start)
+	if err != nil {
+		return CreateSchedule{}, err
+	}
+
+	occurrences := make([]CreateOccurrence, len(payments))
+	for i, p := range payments {
+		occurrences[i] = CreateOccurrence{
+			RunOn: p.RunOn,
+			RunTransfer: RunTransfer{
+				Description: fmt.Sprintf("Loan payment %d of %d: principal %s, interest %s",
+					p.PaymentNumber, len(payments),
+					Amount{Currency: principal.Currency, Value: p.Principal},
+					Amount{Currency: principal.Currency, Value: p.Interest}),
+				Amount:           ScheduleAmount{Currency: principal.Currency, Value: p.Payment},
+				PartnerAccountID: accounts.PartnerAccountID,
+				Source:           accounts.Source,
+				Destination:      accounts.Destination,
+			},
+		}
+	}
+
+	return CreateSchedule{
+		Description: fmt.Sprintf("%d month loan of %s at %v%%", termMonths, principal, annualRatePct),
+		Occurrences: occurrences,
+	}, nil
+}
+
+// addMonths adds months to t, keeping to the last day of the month when the new month is shorter.
+func addMonths(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	first := time.Date(year, month+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(day, lastDay)-1)
+}
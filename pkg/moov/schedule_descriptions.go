@@ -0,0 +1,90 @@
+package moov
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// OccurrenceDescription is what template tokens in an occurrence's transfer description can use, such as
+// "Payment {{.OccurrenceNumber}} of {{.Total}}".
+type OccurrenceDescription struct {
+	// Position of the occurrence when ordered by RunOn, starting at 1
+	OccurrenceNumber int
+	// Number of occurrences in the schedule
+	Total int
+	RunOn time.Time
+}
+
+// PreviewSchedule returns the schedule's occurrences ordered by when they run, with any template tokens in their
+// transfer descriptions expanded the same as CreateSchedule will. Descriptions without tokens are left as they are.
+//
+// Only occurrences listed in the schedule can be previewed. Moov generates the occurrences of a recurrence itself, so
+// its description can't be templated.
+func PreviewSchedule(s CreateSchedule) ([]CreateOccurrence, error) {
+	expanded, err := s.expandDescriptions()
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences := slices.Clone(expanded.Occurrences)
+	slices.SortStableFunc(occurrences, func(a, b CreateOccurrence) int {
+		return a.RunOn.Compare(b.RunOn)
+	})
+	return occurrences, nil
+}
+
+// expandDescriptions returns a copy of the schedule with each occurrence's description template expanded.
+func (s CreateSchedule) expandDescriptions() (CreateSchedule, error) {
+	if s.Recur != nil && isDescriptionTemplate(s.Recur.RunTransfer.Description) {
+		return s, fmt.Errorf("recur.runTransfer.description: %w: Moov generates recurring occurrences so only listed occurrences can use templates", ErrInvalidDescriptionTemplate)
+	}
+
+	// Number occurrences by when they run, as they can be listed in any order
+	order := make([]int, len(s.Occurrences))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return s.Occurrences[a].RunOn.Compare(s.Occurrences[b].RunOn)
+	})
+
+	s.Occurrences = slices.Clone(s.Occurrences)
+	for n, i := range order {
+		occ := &s.Occurrences[i]
+		if !isDescriptionTemplate(occ.RunTransfer.Description) {
+			continue
+		}
+
+		description, err := expandDescription(occ.RunTransfer.Description, OccurrenceDescription{
+			OccurrenceNumber: n + 1,
+			Total:            len(s.Occurrences),
+			RunOn:            occ.RunOn,
+		})
+		if err != nil {
+			return s, fmt.Errorf("occurrences[%d].runTransfer.description: %w", i, err)
+		}
+		occ.RunTransfer.Description = description
+	}
+
+	return s, nil
+}
+
+func isDescriptionTemplate(description string) bool {
+	return strings.Contains(description, "{{")
+}
+
+func expandDescription(description string, data OccurrenceDescription) (string, error) {
+	tmpl, err := template.New("description").Parse(description)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidDescriptionTemplate, err)
+	}
+
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidDescriptionTemplate, err)
+	}
+	return b.String(), nil
+}
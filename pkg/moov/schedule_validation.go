@@ -0,0 +1,140 @@
+package moov
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ScheduleValidationOption changes how ValidateSchedule checks a schedule.
+type ScheduleValidationOption func(v *scheduleValidation)
+type scheduleValidation struct {
+	now  func() time.Time
+	errs []error
+}
+
+// WithScheduleValidationClock sets the clock start dates are checked against. Defaults to time.Now.
+func WithScheduleValidationClock(now func() time.Time) ScheduleValidationOption {
+	return func(v *scheduleValidation) {
+		v.now = now
+	}
+}
+
+// ValidateSchedule checks a schedule for the mistakes Moov would reject it for: each transfer's amount must be positive
+// and in the same currency as every other, sales tax must be a valid amount in that currency too, the recurrence rule
+// must be a valid RFC 5545 RRULE, and start dates can't be in the past. Every problem found is returned joined together.
+// CreateSchedule makes the same checks before sending the schedule.
+func ValidateSchedule(s CreateSchedule, opts ...ScheduleValidationOption) error {
+	return s.validate(opts...)
+}
+
+func (s CreateSchedule) validate(opts ...ScheduleValidationOption) error {
+	v := applyOptions(&scheduleValidation{now: time.Now}, opts)
+	now := v.now()
+
+	var currency string
+	sameCurrency := func(field string, a ScheduleAmount) {
+		if currency == "" {
+			currency = a.Currency
+		} else if a.Currency != currency {
+			v.errs = append(v.errs, fmt.Errorf("%s: %w: %s and %s", field, ErrCurrencyMismatch, currency, a.Currency))
+		}
+	}
+
+	runTransfer := func(field string, r RunTransfer) {
+		if err := r.Amount.Validate(); err != nil {
+			v.errs = append(v.errs, fmt.Errorf("%s.amount: %w", field, err))
+		} else {
+			if r.Amount.Value == 0 {
+				v.errs = append(v.errs, fmt.Errorf("%s.amount: %w: value must be positive", field, ErrInvalidAmount))
+			}
+			sameCurrency(field+".amount", r.Amount)
+		}
+
+		if r.SalesTaxAmount != nil {
+			if err := r.SalesTaxAmount.Validate(); err != nil {
+				v.errs = append(v.errs, fmt.Errorf("%s.salesTaxAmount: %w", field, err))
+			} else {
+				sameCurrency(field+".salesTaxAmount", *r.SalesTaxAmount)
+			}
+		}
+	}
+
+	if s.Recur == nil && len(s.Occurrences) == 0 {
+		v.errs = append(v.errs, errors.New("schedule needs a recurrence or at least one occurrence"))
+	}
+
+	if r := s.Recur; r != nil {
+		runTransfer("recur.runTransfer", r.RunTransfer)
+		if err := validateRecurrenceRule(r.RecurrenceRule); err != nil {
+			v.errs = append(v.errs, fmt.Errorf("recur.recurrenceRule: %w", err))
+		}
+		if r.Start != nil && r.Start.Before(now) {
+			v.errs = append(v.errs, fmt.Errorf("recur.start: %w: %s", ErrScheduleInPast, r.Start.Format(time.RFC3339)))
+		}
+	}
+
+	for i, occ := range s.Occurrences {
+		runTransfer(fmt.Sprintf("occurrences[%d].runTransfer", i), occ.RunTransfer)
+		if occ.RunOn.Before(now) {
+			v.errs = append(v.errs, fmt.Errorf("occurrences[%d].runOn: %w: %s", i, ErrScheduleInPast, occ.RunOn.Format(time.RFC3339)))
+		}
+	}
+
+	return errors.Join(v.errs...)
+}
+
+var rruleFrequencies = []string{"SECONDLY", "MINUTELY", "HOURLY", "DAILY", "WEEKLY", "MONTHLY", "YEARLY"}
+
+var rruleParts = []string{"FREQ", "UNTIL", "COUNT", "INTERVAL", "BYSECOND", "BYMINUTE", "BYHOUR", "BYDAY", "BYMONTHDAY", "BYYEARDAY", "BYWEEKNO", "BYMONTH", "BYSETPOS", "WKST"}
+
+// validateRecurrenceRule checks the rule's structure following RFC 5545 section 3.3.10. It doesn't check the values of
+// the BY* parts.
+func validateRecurrenceRule(rule string) error {
+	rule = strings.TrimPrefix(rule, "RRULE:")
+	if rule == "" {
+		return fmt.Errorf("%w: rule is empty", ErrInvalidRecurrenceRule)
+	}
+
+	parts := make(map[string]string)
+	for _, part := range strings.Split(rule, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		switch {
+		case !ok || value == "":
+			return fmt.Errorf("%w: %q isn't a NAME=VALUE part", ErrInvalidRecurrenceRule, part)
+		case !slices.Contains(rruleParts, name):
+			return fmt.Errorf("%w: unknown part %s", ErrInvalidRecurrenceRule, name)
+		case parts[name] != "":
+			return fmt.Errorf("%w: %s is repeated", ErrInvalidRecurrenceRule, name)
+		}
+		parts[name] = value
+	}
+
+	if freq := parts["FREQ"]; !slices.Contains(rruleFrequencies, freq) {
+		return fmt.Errorf("%w: FREQ must be one of %s", ErrInvalidRecurrenceRule, strings.Join(rruleFrequencies, ", "))
+	}
+
+	for _, name := range []string{"COUNT", "INTERVAL"} {
+		if value, ok := parts[name]; ok {
+			if n, err := strconv.Atoi(value); err != nil || n < 1 {
+				return fmt.Errorf("%w: %s must be a positive integer", ErrInvalidRecurrenceRule, name)
+			}
+		}
+	}
+
+	if until, ok := parts["UNTIL"]; ok {
+		if _, hasCount := parts["COUNT"]; hasCount {
+			return fmt.Errorf("%w: UNTIL and COUNT can't both be set", ErrInvalidRecurrenceRule)
+		}
+		if _, err := time.Parse("20060102T150405Z", until); err != nil {
+			if _, err := time.Parse("20060102", until); err != nil {
+				return fmt.Errorf("%w: UNTIL %q must be a date or UTC date-time", ErrInvalidRecurrenceRule, until)
+			}
+		}
+	}
+
+	return nil
+}
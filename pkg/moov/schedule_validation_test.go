@@ -0,0 +1,112 @@
+package moov_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/moovfinancial/moov-go/pkg/moov"
+)
+
+func Test_ValidateSchedule(t *testing.T) {
+	now := time.Date(2024, time.January, 10, 12, 0, 0, 0, time.UTC)
+	clock := moov.WithScheduleValidationClock(func() time.Time { return now })
+
+	occurrence := func(value int64, currency string, runOn time.Time) moov.CreateOccurrence {
+		return moov.CreateOccurrence{
+			RunOn:       runOn,
+			RunTransfer: moov.RunTransfer{Amount: moov.ScheduleAmount{Value: value, Currency: currency}},
+		}
+	}
+
+	tomorrow := now.AddDate(0, 0, 1)
+	valid := func() moov.CreateSchedule {
+		return moov.CreateSchedule{
+			Recur: &moov.Recur{
+				Start:          &tomorrow,
+				RecurrenceRule: "FREQ=MONTHLY;INTERVAL=1;COUNT=6",
+				RunTransfer:    moov.RunTransfer{Amount: moov.ScheduleAmount{Value: 1000, Currency: "USD"}},
+			},
+			Occurrences: []moov.CreateOccurrence{
+				occurrence(250, "USD", tomorrow),
+			},
+		}
+	}
+
+	require.NoError(t, moov.ValidateSchedule(valid(), clock))
+
+	t.Run("non-positive amounts", func(t *testing.T) {
+		s := valid()
+		s.Occurrences = append(s.Occurrences, occurrence(0, "USD", tomorrow), occurrence(-5, "USD", tomorrow))
+
+		err := moov.ValidateSchedule(s, clock)
+		require.ErrorIs(t, err, moov.ErrInvalidAmount)
+		require.ErrorContains(t, err, "occurrences[1].runTransfer.amount")
+		require.ErrorContains(t, err, "occurrences[2].runTransfer.amount")
+	})
+
+	t.Run("mixed currencies", func(t *testing.T) {
+		s := valid()
+		s.Occurrences = append(s.Occurrences, occurrence(250, "CAD", tomorrow))
+
+		err := moov.ValidateSchedule(s, clock)
+		require.ErrorIs(t, err, moov.ErrCurrencyMismatch)
+		require.ErrorContains(t, err, "occurrences[1]")
+	})
+
+	t.Run("sales tax", func(t *testing.T) {
+		s := valid()
+		s.Recur.RunTransfer.SalesTaxAmount = &moov.ScheduleAmount{Value: 80, Currency: "usd"}
+		s.Occurrences[0].RunTransfer.SalesTaxAmount = &moov.ScheduleAmount{Value: 20, Currency: "CAD"}
+
+		err := moov.ValidateSchedule(s, clock)
+		require.ErrorIs(t, err, moov.ErrInvalidAmount)
+		require.ErrorContains(t, err, "recur.runTransfer.salesTaxAmount")
+		require.ErrorIs(t, err, moov.ErrCurrencyMismatch)
+		require.ErrorContains(t, err, "occurrences[0].runTransfer.salesTaxAmount")
+	})
+
+	t.Run("invalid recurrence rules", func(t *testing.T) {
+		for _, rule := range []string{
+			"",
+			"FREQ=FORTNIGHTLY",
+			"INTERVAL=2",
+			"FREQ=DAILY;COUNT=0",
+			"FREQ=DAILY;COUNT=3;UNTIL=20240301T000000Z",
+			"FREQ=DAILY;UNTIL=next-week",
+			"FREQ=DAILY;BYFORTNIGHT=1",
+			"FREQ=DAILY;FREQ=WEEKLY",
+		} {
+			s := valid()
+			s.Recur.RecurrenceRule = rule
+
+			require.ErrorIs(t, moov.ValidateSchedule(s, clock), moov.ErrInvalidRecurrenceRule, rule)
+		}
+	})
+
+	t.Run("starts in the past", func(t *testing.T) {
+		yesterday := now.AddDate(0, 0, -1)
+
+		s := valid()
+		s.Recur.Start = &yesterday
+		s.Occurrences[0].RunOn = yesterday
+
+		err := moov.ValidateSchedule(s, clock)
+		require.ErrorIs(t, err, moov.ErrScheduleInPast)
+		require.ErrorContains(t, err, "recur.start")
+		require.ErrorContains(t, err, "occurrences[0].runOn")
+	})
+
+	t.Run("errors are aggregated", func(t *testing.T) {
+		s := valid()
+		s.Recur.RecurrenceRule = "FREQ=HOURLY;COUNT=-1"
+		s.Occurrences = append(s.Occurrences, occurrence(0, "EUR", now.Add(-time.Hour)))
+
+		err := moov.ValidateSchedule(s, clock)
+		require.ErrorIs(t, err, moov.ErrInvalidRecurrenceRule)
+		require.ErrorIs(t, err, moov.ErrInvalidAmount)
+		require.ErrorIs(t, err, moov.ErrCurrencyMismatch)
+		require.ErrorIs(t, err, moov.ErrScheduleInPast)
+	})
+}
@@ -2,22 +2,85 @@ package moov
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"slices"
 	"time"
+
+	"github.com/google/uuid"
 )
 
+type CreateScheduleArgs callArg
+
+// Can be specified to overwrite a randomly generated one. Reusing the same key when retrying makes creating the
+// schedule safe to repeat, such as from a job runner that may fire twice.
+func WithScheduleIdempotencyKey(key uuid.UUID) CreateScheduleArgs {
+	return IdempotencyKey(key.String())
+}
+
+// WithScheduleOperationID reuses the idempotency key of earlier attempts at the same operation, as remembered by the
+// client's IdempotencyStore, so retrying after a restart returns the schedule created by the first attempt.
+func WithScheduleOperationID(operationID string) CreateScheduleArgs {
+	return withOperationID(operationID)
+}
+
+// If the idempotency key was already used to create a schedule the existing schedule is returned instead of an error.
+// Template tokens in occurrence descriptions are expanded for each occurrence, see PreviewSchedule. The schedule is checked
+// with ValidateSchedule before it's sent.
 // Guide: https://docs.moov.io/guides/money-movement/scheduling/
 // Documentation: https://docs.moov.io/api/money-movement/schedules/create/
-func (c Client) CreateSchedule(ctx context.Context, accountID string, schedule CreateSchedule) (*Schedule, error) {
-	resp, err := c.CallHttp(ctx,
-		Endpoint(http.MethodPost, pathSchedules, accountID),
+func (c Client) CreateSchedule(ctx context.Context, accountID string, schedule CreateSchedule, options ...CreateScheduleArgs) (*Schedule, error) {
+	if err := schedule.validate(); err != nil {
+		return nil, err
+	}
+
+	schedule, err := schedule.expandDescriptions()
+	if err != nil {
+		return nil, err
+	}
+
+	args := prependArgs(options,
 		AcceptJson(),
-		JsonBody(schedule))
+		WithScheduleIdempotencyKey(uuid.New()),
+		JsonBody(schedule),
+	)
+
+	resp, err := c.CallHttp(ctx, Endpoint(http.MethodPost, pathSchedules, accountID), args...)
 	if err != nil {
 		return nil, err
 	}
 
-	return CompletedObjectOrError[Schedule](resp)
+	switch resp.Status() {
+	case StatusCompleted:
+		return UnmarshalObjectResponse[Schedule](resp)
+	case StatusStateConflict:
+		if !c.sentIdempotencyKey(resp) {
+			return nil, resp
+		}
+		return c.existingSchedule(ctx, accountID, resp)
+	default:
+		return nil, resp
+	}
+}
+
+// existingSchedule resolves the schedule that was already created with the idempotency key of a conflicting request.
+// Conflicts whose body doesn't identify a schedule aren't about the idempotency key and are returned as they are.
+func (c Client) existingSchedule(ctx context.Context, accountID string, resp CallResponse) (*Schedule, error) {
+	existing, err := UnmarshalObjectResponse[Schedule](resp)
+	if err != nil || existing.ScheduleID == "" {
+		return nil, resp
+	}
+
+	// Conflict only referenced the schedule so go fetch the rest of it
+	if existing.Recur == nil && len(existing.Occurrences) == 0 {
+		fetched, err := c.GetSchedule(ctx, accountID, existing.ScheduleID)
+		if err != nil {
+			return nil, &IdempotencyConflictError{ScheduleID: existing.ScheduleID, Err: err}
+		}
+		return fetched, nil
+	}
+
+	return existing, nil
 }
 
 // Guide: https://docs.moov.io/guides/money-movement/scheduling/
@@ -63,14 +126,83 @@ func (c Client) UpdateSchedule(ctx context.Context, accountID string, scheduleID
 // Guide: https://docs.moov.io/guides/money-movement/scheduling/
 // Documentation: https://docs.moov.io/api/money-movement/schedules/delete/
 func (c Client) CancelSchedule(ctx context.Context, accountID string, scheduleID string) error {
-	resp, err := c.CallHttp(ctx,
-		Endpoint(http.MethodDelete, pathSchedule, accountID, scheduleID),
-		AcceptJson())
+	return c.delete(ctx, Endpoint(http.MethodDelete, pathSchedule, accountID, scheduleID))
+}
+
+// PauseSchedule cancels every occurrence of the schedule that's still scheduled to run, leaving the schedule itself
+// enabled and its recurrence rule unchanged, so it can be resumed with ResumeSchedule. The schedule returned reports
+// ScheduleStatus_Paused. Moov generates the occurrences of a recurrence up front unless it's indefinite, so
+// ErrScheduleIndefinite is returned for those as occurrences generated later wouldn't be paused.
+// Guide: https://docs.moov.io/guides/money-movement/scheduling/
+func (c Client) PauseSchedule(ctx context.Context, partnerAccountID string, scheduleID string) (*Schedule, error) {
+	schedule, err := c.GetSchedule(ctx, partnerAccountID, scheduleID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if schedule.Recur != nil && schedule.Recur.Indefinite {
+		return nil, fmt.Errorf("%w: schedule %s can't be paused", ErrScheduleIndefinite, scheduleID)
 	}
 
-	return CompletedNilOrError(resp)
+	scheduled := filterList(schedule.Occurrences, []OccurrenceFilter{
+		WithOccurrenceStatus(OccurrenceStatus_Scheduled),
+		func(occ Occurrence) bool { return occ.RanOn == nil },
+	})
+
+	return c.setOccurrencesCanceled(ctx, partnerAccountID, schedule, scheduled, true)
+}
+
+// ResumeSchedule restores the occurrences PauseSchedule canceled that are still to come. Occurrences that came due
+// while the schedule was paused are skipped, and those canceled before it was paused stay canceled.
+// Guide: https://docs.moov.io/guides/money-movement/scheduling/
+func (c Client) ResumeSchedule(ctx context.Context, partnerAccountID string, scheduleID string) (*Schedule, error) {
+	schedule, err := c.GetSchedule(ctx, partnerAccountID, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Occurrences canceled while the schedule is still running weren't canceled by a pause
+	if schedule.Status() != ScheduleStatus_Paused {
+		return schedule, nil
+	}
+
+	return c.setOccurrencesCanceled(ctx, partnerAccountID, schedule, schedule.pausedOccurrences(time.Now()), false)
+}
+
+// setOccurrencesCanceled cancels or restores the schedule's occurrences in a single update. The update is built from
+// the whole schedule so the recurrence rule and the other occurrences are sent back unchanged.
+func (c Client) setOccurrencesCanceled(ctx context.Context, partnerAccountID string, schedule *Schedule, occurrences []Occurrence, canceled bool) (*Schedule, error) {
+	// Nothing to change so the schedule is already in the requested state
+	if len(occurrences) == 0 {
+		return schedule, nil
+	}
+
+	update := schedule.ToUpdateSchedule()
+	for i, upd := range update.Occurrences {
+		if slices.ContainsFunc(occurrences, func(occ Occurrence) bool { return occ.OccurrenceID == *upd.OccurrenceID }) {
+			update.Occurrences[i].Canceled = PtrOf(canceled)
+		}
+	}
+
+	return c.UpdateSchedule(ctx, partnerAccountID, schedule.ScheduleID, update)
+}
+
+// CancelOccurrencesAfter cancels each occurrence of the schedule that's still scheduled to run after the cutoff, such
+// as the remaining installments of a loan that was paid off early. Occurrences that already ran, are running, or were
+// canceled are left as they are. The occurrences are canceled together in a single update of the schedule, which sends
+// its recurrence rule and other occurrences back unchanged.
+// Guide: https://docs.moov.io/guides/money-movement/scheduling/
+func (c Client) CancelOccurrencesAfter(ctx context.Context, partnerAccountID, scheduleID string, after time.Time) (*Schedule, error) {
+	schedule, err := c.GetSchedule(ctx, partnerAccountID, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	upcoming := filterList(schedule.Occurrences, []OccurrenceFilter{
+		WithOccurrenceStatus(OccurrenceStatus_Scheduled),
+		func(occ Occurrence) bool { return occ.RunOn.After(after) },
+	})
+
+	return c.setOccurrencesCanceled(ctx, partnerAccountID, schedule, upcoming, true)
 }
 
 type scheduleOccurrenceFilterArg func() string
@@ -90,6 +222,37 @@ func OccurrenceLatestToTime(t time.Time) scheduleOccurrenceFilterArg {
 	return func() string { return t.UTC().Format(time.RFC3339) }
 }
 
+// OccurrenceFilter narrows the occurrences returned by ListOccurrences. Moov returns occurrences as part of their
+// schedule, so filters are applied client-side.
+type OccurrenceFilter func(occ Occurrence) bool
+
+// WithOccurrenceStatus keeps occurrences in any of the given statuses, see Occurrence.CurrentStatus
+func WithOccurrenceStatus(statuses ...OccurrenceStatus) OccurrenceFilter {
+	return func(occ Occurrence) bool {
+		return slices.Contains(statuses, occ.CurrentStatus())
+	}
+}
+
+// WithOccurrenceRunOnBetween keeps occurrences set to run at or after start and before end. A zero start or end leaves
+// that side of the range open.
+func WithOccurrenceRunOnBetween(start, end time.Time) OccurrenceFilter {
+	return func(occ Occurrence) bool {
+		return (start.IsZero() || !occ.RunOn.Before(start)) && (end.IsZero() || occ.RunOn.Before(end))
+	}
+}
+
+// ListOccurrences returns the schedule's occurrences matching every filter, such as the installments of a loan that
+// failed.
+// Guide: https://docs.moov.io/guides/money-movement/scheduling/
+func (c Client) ListOccurrences(ctx context.Context, accountID string, scheduleID string, filters ...OccurrenceFilter) ([]Occurrence, error) {
+	schedule, err := c.GetSchedule(ctx, accountID, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterList(schedule.Occurrences, filters), nil
+}
+
 // Guide: https://docs.moov.io/guides/money-movement/scheduling/
 func (c Client) GetScheduleOccurrence(ctx context.Context, accountID string, scheduleID string, filter scheduleOccurrenceFilterArg) (*Occurrence, error) {
 	resp, err := c.CallHttp(ctx,
@@ -1,6 +1,10 @@
 package moov
 
-import "time"
+import (
+	"fmt"
+	"strings"
+	"time"
+)
 
 type Schedule struct {
 	// prod or sandbox
@@ -40,6 +44,58 @@ type Schedule struct {
 	DisabledOn *time.Time `json:"disabledOn,omitempty"`
 }
 
+// ScheduleStatus describes whether a schedule will continue to run its occurrences.
+type ScheduleStatus string
+
+// List of ScheduleStatus
+const (
+	ScheduleStatus_Active   ScheduleStatus = "active"
+	ScheduleStatus_Paused   ScheduleStatus = "paused"
+	ScheduleStatus_Disabled ScheduleStatus = "disabled"
+)
+
+// Occurrences canceled by a single update have their CanceledOn set this close together.
+const pausedWithin = time.Minute
+
+// Status reports if the schedule is active, paused, or disabled. Moov doesn't record that a schedule was paused, so
+// it's paused when none of its occurrences are left to run and the last ones canceled, together, are still to come.
+// A schedule whose remaining occurrences were all canceled at once some other way, such as with
+// CancelOccurrencesAfter, is reported as paused too.
+func (s Schedule) Status() ScheduleStatus {
+	if s.DisabledOn != nil {
+		return ScheduleStatus_Disabled
+	}
+	for _, occ := range s.Occurrences {
+		if occ.RanOn == nil && occ.CurrentStatus() == OccurrenceStatus_Scheduled {
+			return ScheduleStatus_Active
+		}
+	}
+	if len(s.pausedOccurrences(time.Now())) > 0 {
+		return ScheduleStatus_Paused
+	}
+	return ScheduleStatus_Active
+}
+
+// pausedOccurrences returns the occurrences running after now that were canceled last, together in one update, which
+// are the ones PauseSchedule canceled when the schedule is paused.
+func (s Schedule) pausedOccurrences(now time.Time) []Occurrence {
+	var canceled []Occurrence
+	var latest time.Time
+	for _, occ := range s.Occurrences {
+		if occ.RanOn != nil || occ.CanceledOn == nil || !occ.RunOn.After(now) {
+			continue
+		}
+		canceled = append(canceled, occ)
+		if occ.CanceledOn.After(latest) {
+			latest = *occ.CanceledOn
+		}
+	}
+
+	return filterList(canceled, []OccurrenceFilter{func(occ Occurrence) bool {
+		return latest.Sub(*occ.CanceledOn) < pausedWithin
+	}})
+}
+
 func (s Schedule) ToUpdateSchedule() UpdateSchedule {
 	upsOccs := make([]UpdateOccurrence, len(s.Occurrences))
 	for i, occ := range s.Occurrences {
@@ -117,6 +173,31 @@ type Occurrence struct {
 	Error *OccurrenceError `json:"error,omitempty" spanner:"error" otel:"error"`
 }
 
+// OccurrenceStatus describes where an occurrence is in running its transfer.
+type OccurrenceStatus string
+
+// List of OccurrenceStatus
+const (
+	OccurrenceStatus_Scheduled OccurrenceStatus = "scheduled"
+	OccurrenceStatus_Pending   OccurrenceStatus = "pending"
+	OccurrenceStatus_Completed OccurrenceStatus = "completed"
+	OccurrenceStatus_Failed    OccurrenceStatus = "failed"
+	OccurrenceStatus_Canceled  OccurrenceStatus = "canceled"
+)
+
+// CurrentStatus returns the occurrence's status. Moov only sets Status once an occurrence runs, before then it's
+// canceled if CanceledOn is set and scheduled otherwise.
+func (o Occurrence) CurrentStatus() OccurrenceStatus {
+	switch {
+	case o.Status != nil:
+		return OccurrenceStatus(*o.Status)
+	case o.CanceledOn != nil:
+		return OccurrenceStatus_Canceled
+	default:
+		return OccurrenceStatus_Scheduled
+	}
+}
+
 // OccurrenceError is where we log any errors or failures that could happen from running the occurrence.
 type OccurrenceError struct {
 	Message string `json:"message,omitempty" otel:"message"`
@@ -167,6 +248,8 @@ type UpdateOccurrence struct {
 }
 
 type RunTransfer struct {
+	// Description of the transfer. Listed occurrences can number their descriptions with template tokens, see
+	// OccurrenceDescription.
 	Description string `json:"description,omitempty"`
 
 	Amount         ScheduleAmount  `json:"amount,omitempty"`
@@ -182,6 +265,24 @@ type ScheduleAmount struct {
 	Currency string `json:"currency,omitempty"`
 }
 
+// Validate checks the currency is an uppercase, 3-letter ISO 4217 code and the value, in the currency's minor units,
+// isn't negative.
+func (a ScheduleAmount) Validate() error {
+	if len(a.Currency) != 3 || strings.IndexFunc(a.Currency, notUpperLetter) >= 0 {
+		return fmt.Errorf("%w: currency %q must be an uppercase 3-letter ISO 4217 code such as USD", ErrInvalidAmount, a.Currency)
+	}
+
+	if a.Value < 0 {
+		return fmt.Errorf("%w: value %d must not be negative", ErrInvalidAmount, a.Value)
+	}
+
+	return nil
+}
+
+func notUpperLetter(r rune) bool {
+	return r < 'A' || r > 'Z'
+}
+
 type SchedulePaymentMethod struct {
 	PaymentMethodID string `json:"paymentMethodID,omitempty"`
 
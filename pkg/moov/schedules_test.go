@@ -1,10 +1,14 @@
 package moov_test
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/moovfinancial/moov-go/internal/testtools"
 	"github.com/moovfinancial/moov-go/pkg/moov"
 	"github.com/stretchr/testify/require"
@@ -173,3 +177,503 @@ func Test_Schedules(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func Test_PauseAndResumeSchedule(t *testing.T) {
+	ranOn := time.Date(2040, time.March, 1, 0, 0, 0, 0, time.UTC)
+	skippedOn := time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	schedule := moov.Schedule{
+		ScheduleID:  "schedule-id",
+		Description: "Loan repayments",
+		Recur: &moov.Recur{
+			RecurrenceRule: "FREQ=MONTHLY;COUNT=4",
+		},
+		Occurrences: []moov.Occurrence{
+			{OccurrenceID: "ran", RunOn: ranOn, RanOn: &ranOn},
+			{OccurrenceID: "skipped", RunOn: ranOn.AddDate(0, 1, 0), CanceledOn: &skippedOn},
+			{OccurrenceID: "next", RunOn: ranOn.AddDate(0, 2, 0)},
+			{OccurrenceID: "last", RunOn: ranOn.AddDate(0, 3, 0)},
+		},
+	}
+	require.Equal(t, moov.ScheduleStatus_Active, schedule.Status())
+
+	var updates []moov.UpdateSchedule
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/accounts/account-id/schedules/schedule-id" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			writeJson(t, w, http.StatusOK, schedule)
+		case http.MethodPut:
+			var update moov.UpdateSchedule
+			if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+				t.Errorf("decoding update: %v", err)
+			}
+			updates = append(updates, update)
+
+			canceledOn := time.Now()
+			for _, upd := range update.Occurrences {
+				for i, occ := range schedule.Occurrences {
+					if occ.OccurrenceID != *upd.OccurrenceID || upd.Canceled == nil {
+						continue
+					}
+					schedule.Occurrences[i].CanceledOn = nil
+					if *upd.Canceled {
+						schedule.Occurrences[i].CanceledOn = &canceledOn
+					}
+				}
+			}
+			writeJson(t, w, http.StatusOK, schedule)
+		}
+	}))
+
+	paused, err := mc.PauseSchedule(BgCtx(), "account-id", "schedule-id")
+	require.NoError(t, err)
+	require.Equal(t, moov.ScheduleStatus_Paused, paused.Status())
+
+	// The whole schedule is sent back so the recurrence rule is kept
+	require.Len(t, updates, 1)
+	require.Equal(t, "Loan repayments", updates[0].Description)
+	require.Equal(t, schedule.Recur, updates[0].Recur)
+	require.Len(t, updates[0].Occurrences, 4)
+	for _, occ := range updates[0].Occurrences {
+		switch *occ.OccurrenceID {
+		case "next", "last":
+			require.True(t, *occ.Canceled)
+		default:
+			require.Nil(t, occ.Canceled, *occ.OccurrenceID)
+		}
+	}
+
+	// Pausing again doesn't need an update
+	again, err := mc.PauseSchedule(BgCtx(), "account-id", "schedule-id")
+	require.NoError(t, err)
+	require.Equal(t, moov.ScheduleStatus_Paused, again.Status())
+	require.Len(t, updates, 1)
+
+	resumed, err := mc.ResumeSchedule(BgCtx(), "account-id", "schedule-id")
+	require.NoError(t, err)
+	require.Equal(t, moov.ScheduleStatus_Active, resumed.Status())
+
+	// The occurrence canceled before pausing stays canceled
+	require.Len(t, updates, 2)
+	require.Equal(t, schedule.Recur, updates[1].Recur)
+	for _, occ := range updates[1].Occurrences {
+		switch *occ.OccurrenceID {
+		case "next", "last":
+			require.False(t, *occ.Canceled)
+		default:
+			require.Nil(t, occ.Canceled, *occ.OccurrenceID)
+		}
+	}
+	require.Equal(t, &skippedOn, resumed.Occurrences[1].CanceledOn)
+
+	// Resuming again doesn't need an update
+	_, err = mc.ResumeSchedule(BgCtx(), "account-id", "schedule-id")
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+
+	t.Run("indefinite", func(t *testing.T) {
+		schedule.Recur.Indefinite = true
+
+		_, err := mc.PauseSchedule(BgCtx(), "account-id", "schedule-id")
+		require.ErrorIs(t, err, moov.ErrScheduleIndefinite)
+		require.Len(t, updates, 2)
+	})
+}
+
+// newMockSchedule returns the smallest schedule that passes validation, for tests sending it to a mock client
+func newMockSchedule() moov.CreateSchedule {
+	return moov.CreateSchedule{
+		Occurrences: []moov.CreateOccurrence{{
+			RunOn:       time.Date(2040, time.March, 1, 0, 0, 0, 0, time.UTC),
+			RunTransfer: moov.RunTransfer{Amount: moov.ScheduleAmount{Value: 100, Currency: "USD"}},
+		}},
+	}
+}
+
+func Test_CreateSchedule_IdempotencyConflict(t *testing.T) {
+	key := uuid.New()
+	existing := moov.Schedule{
+		ScheduleID:  "schedule-id",
+		Description: "already created",
+		Occurrences: []moov.Occurrence{{OccurrenceID: "occurrence-id"}},
+	}
+
+	getStatus := http.StatusOK
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/accounts/account-id/schedules":
+			require.Equal(t, key.String(), r.Header.Get("X-Idempotency-Key"))
+			writeJson(t, w, http.StatusConflict, map[string]string{"scheduleID": existing.ScheduleID})
+		case r.Method == http.MethodGet && r.URL.Path == "/accounts/account-id/schedules/schedule-id":
+			writeJson(t, w, getStatus, existing)
+		default:
+			t.Fatalf("unexpected call %s %s", r.Method, r.URL.Path)
+		}
+	}))
+
+	t.Run("returns existing schedule", func(t *testing.T) {
+		schedule, err := mc.CreateSchedule(BgCtx(), "account-id", newMockSchedule(), moov.WithScheduleIdempotencyKey(key))
+		require.NoError(t, err)
+		require.Equal(t, existing, *schedule)
+	})
+
+	t.Run("existing schedule can't be fetched", func(t *testing.T) {
+		getStatus = http.StatusNotFound
+
+		schedule, err := mc.CreateSchedule(BgCtx(), "account-id", newMockSchedule(), moov.WithScheduleIdempotencyKey(key))
+		require.Nil(t, schedule)
+
+		var conflict *moov.IdempotencyConflictError
+		require.ErrorAs(t, err, &conflict)
+		require.Equal(t, existing.ScheduleID, conflict.ScheduleID)
+		require.Equal(t, moov.StatusNotFound, moov.ErrorAsCallResponse(err).Status())
+	})
+}
+
+func Test_CreateSchedule_OtherConflicts(t *testing.T) {
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected call %s %s", r.Method, r.URL.Path)
+		}
+		if r.Header.Get("X-Idempotency-Key") == "" {
+			writeJson(t, w, http.StatusConflict, map[string]string{"scheduleID": "schedule-id"})
+			return
+		}
+		writeJson(t, w, http.StatusConflict, map[string]string{"error": "payment method is disabled"})
+	}))
+
+	t.Run("body doesn't identify a schedule", func(t *testing.T) {
+		_, err := mc.CreateSchedule(BgCtx(), "account-id", newMockSchedule())
+		require.Equal(t, moov.StatusStateConflict, moov.ErrorAsCallResponse(err).Status())
+
+		var conflict *moov.IdempotencyConflictError
+		require.False(t, errors.As(err, &conflict))
+	})
+
+	t.Run("sent without an idempotency key", func(t *testing.T) {
+		schedule, err := mc.CreateSchedule(BgCtx(), "account-id", newMockSchedule(), moov.WithoutIdempotencyKey())
+		require.Nil(t, schedule)
+		require.Equal(t, moov.StatusStateConflict, moov.ErrorAsCallResponse(err).Status())
+	})
+}
+
+func Test_ScheduleAmount_Validate(t *testing.T) {
+	valid := []moov.ScheduleAmount{
+		{Value: 0, Currency: "USD"},
+		{Value: 100, Currency: "CAD"},
+	}
+	for _, a := range valid {
+		require.NoError(t, a.Validate(), a)
+	}
+
+	invalid := []moov.ScheduleAmount{
+		{Value: 100, Currency: "usd"},
+		{Value: 100, Currency: "US"},
+		{Value: 100, Currency: "USDD"},
+		{Value: 100, Currency: "U$D"},
+		{Value: 100, Currency: ""},
+		{Value: -1, Currency: "USD"},
+	}
+	for _, a := range invalid {
+		require.ErrorIs(t, a.Validate(), moov.ErrInvalidAmount, a)
+	}
+
+	t.Run("checked before creating", func(t *testing.T) {
+		mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Fatal("schedule with an invalid amount shouldn't be sent")
+		}))
+
+		_, err := mc.CreateSchedule(BgCtx(), "account-id", moov.CreateSchedule{
+			Occurrences: []moov.CreateOccurrence{
+				{RunTransfer: moov.RunTransfer{Amount: moov.ScheduleAmount{Value: 100, Currency: "USD"}}},
+				{RunTransfer: moov.RunTransfer{Amount: moov.ScheduleAmount{Value: 100, Currency: "usd"}}},
+			},
+		})
+		require.ErrorIs(t, err, moov.ErrInvalidAmount)
+		require.Contains(t, err.Error(), "occurrences[1].runTransfer.amount")
+	})
+}
+
+func Test_ListOccurrences(t *testing.T) {
+	first := time.Date(2040, time.March, 1, 0, 0, 0, 0, time.UTC)
+	status := func(s moov.OccurrenceStatus) *string {
+		str := string(s)
+		return &str
+	}
+
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/accounts/account-id/schedules/schedule-id", r.URL.Path)
+
+		writeJson(t, w, http.StatusOK, moov.Schedule{
+			ScheduleID: "schedule-id",
+			Occurrences: []moov.Occurrence{
+				{OccurrenceID: "march", RunOn: first, RanOn: &first, Status: status(moov.OccurrenceStatus_Completed)},
+				{OccurrenceID: "april", RunOn: first.AddDate(0, 1, 0), RanOn: &first, Status: status(moov.OccurrenceStatus_Failed)},
+				{OccurrenceID: "may", RunOn: first.AddDate(0, 2, 0), CanceledOn: &first},
+				{OccurrenceID: "june", RunOn: first.AddDate(0, 3, 0)},
+			},
+		})
+	}))
+
+	ids := func(occs []moov.Occurrence) []string {
+		var ids []string
+		for _, occ := range occs {
+			ids = append(ids, occ.OccurrenceID)
+		}
+		return ids
+	}
+
+	all, err := mc.ListOccurrences(BgCtx(), "account-id", "schedule-id")
+	require.NoError(t, err)
+	require.Equal(t, []string{"march", "april", "may", "june"}, ids(all))
+
+	failed, err := mc.ListOccurrences(BgCtx(), "account-id", "schedule-id", moov.WithOccurrenceStatus(moov.OccurrenceStatus_Failed))
+	require.NoError(t, err)
+	require.Equal(t, []string{"april"}, ids(failed))
+
+	upcoming, err := mc.ListOccurrences(BgCtx(), "account-id", "schedule-id",
+		moov.WithOccurrenceStatus(moov.OccurrenceStatus_Scheduled, moov.OccurrenceStatus_Canceled),
+		moov.WithOccurrenceRunOnBetween(first.AddDate(0, 1, 0), first.AddDate(0, 3, 0)))
+	require.NoError(t, err)
+	require.Equal(t, []string{"may"}, ids(upcoming))
+}
+
+func Test_PreviewSchedule(t *testing.T) {
+	first := time.Date(2040, time.March, 1, 0, 0, 0, 0, time.UTC)
+	amount := moov.ScheduleAmount{Value: 10000, Currency: "USD"}
+
+	// Listed out of order, numbered by when they run
+	schedule := moov.CreateSchedule{
+		Description: "Loan 1234",
+		Occurrences: []moov.CreateOccurrence{
+			{RunOn: first.AddDate(0, 1, 0), RunTransfer: moov.RunTransfer{Amount: amount, Description: "Payment {{.OccurrenceNumber}} of {{.Total}}"}},
+			{RunOn: first, RunTransfer: moov.RunTransfer{Amount: amount, Description: "Payment {{.OccurrenceNumber}} of {{.Total}}"}},
+		},
+	}
+
+	occurrences, err := moov.PreviewSchedule(schedule)
+	require.NoError(t, err)
+	require.Len(t, occurrences, 2)
+	require.Equal(t, first, occurrences[0].RunOn)
+	require.Equal(t, "Payment 1 of 2", occurrences[0].RunTransfer.Description)
+	require.Equal(t, "Payment 2 of 2", occurrences[1].RunTransfer.Description)
+
+	// Previewing doesn't change the schedule
+	require.Equal(t, "Payment {{.OccurrenceNumber}} of {{.Total}}", schedule.Occurrences[0].RunTransfer.Description)
+
+	t.Run("expanded when created", func(t *testing.T) {
+		mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var got moov.CreateSchedule
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+			require.Equal(t, "Payment 2 of 2", got.Occurrences[0].RunTransfer.Description)
+			require.Equal(t, "Payment 1 of 2", got.Occurrences[1].RunTransfer.Description)
+
+			writeJson(t, w, http.StatusOK, moov.Schedule{ScheduleID: "schedule-id"})
+		}))
+
+		_, err := mc.CreateSchedule(BgCtx(), "account-id", schedule)
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid templates", func(t *testing.T) {
+		_, err := moov.PreviewSchedule(moov.CreateSchedule{
+			Occurrences: []moov.CreateOccurrence{{RunTransfer: moov.RunTransfer{Description: "Payment {{.Number}}"}}},
+		})
+		require.ErrorIs(t, err, moov.ErrInvalidDescriptionTemplate)
+		require.Contains(t, err.Error(), "occurrences[0].runTransfer.description")
+
+		_, err = moov.PreviewSchedule(moov.CreateSchedule{
+			Recur: &moov.Recur{RunTransfer: moov.RunTransfer{Description: "Payment {{.OccurrenceNumber}}"}},
+		})
+		require.ErrorIs(t, err, moov.ErrInvalidDescriptionTemplate)
+
+		// Descriptions without tokens are untouched
+		occurrences, err := moov.PreviewSchedule(moov.CreateSchedule{
+			Occurrences: []moov.CreateOccurrence{{RunTransfer: moov.RunTransfer{Description: "Rent {.5 off}"}}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "Rent {.5 off}", occurrences[0].RunTransfer.Description)
+	})
+}
+
+func Test_BuildAmortizedSchedule(t *testing.T) {
+	principal := moov.Amount{Currency: "USD", Value: 10_000_00}
+	start := time.Date(2026, time.January, 31, 15, 0, 0, 0, time.UTC)
+	accounts := moov.LoanAccounts{
+		Source:      moov.SchedulePaymentMethod{PaymentMethodID: "borrower-pm"},
+		Destination: moov.SchedulePaymentMethod{PaymentMethodID: "lender-pm"},
+	}
+
+	// $10,000 at 6% over a year is $860.66 a month, with the last payment covering the rounding
+	payments, err := moov.Amortize(principal, 6, 12, start)
+	require.NoError(t, err)
+	require.Len(t, payments, 12)
+
+	require.Equal(t, moov.AmortizedPayment{
+		PaymentNumber: 1,
+		RunOn:         start,
+		Payment:       860_66,
+		Principal:     810_66,
+		Interest:      50_00,
+		Balance:       9_189_34,
+	}, payments[0])
+	require.Equal(t, time.Date(2026, time.February, 28, 15, 0, 0, 0, time.UTC), payments[1].RunOn)
+	require.Equal(t, moov.AmortizedPayment{
+		PaymentNumber: 12,
+		RunOn:         time.Date(2026, time.December, 31, 15, 0, 0, 0, time.UTC),
+		Payment:       860_70,
+		Principal:     856_42,
+		Interest:      4_28,
+		Balance:       0,
+	}, payments[11])
+
+	var paid, interest int64
+	for _, p := range payments[:11] {
+		require.Equal(t, int64(860_66), p.Payment)
+		paid += p.Principal
+		interest += p.Interest
+	}
+	paid += payments[11].Principal
+	interest += payments[11].Interest
+	require.Equal(t, principal.Value, paid)
+	require.Equal(t, int64(327_96), interest)
+
+	schedule, err := moov.BuildAmortizedSchedule(principal, 6, 12, start, accounts)
+	require.NoError(t, err)
+	require.Nil(t, schedule.Recur)
+	require.Len(t, schedule.Occurrences, 12)
+	require.NoError(t, moov.ValidateSchedule(schedule, moov.WithScheduleValidationClock(func() time.Time { return start.Add(-time.Hour) })))
+
+	var total int64
+	for i, occ := range schedule.Occurrences {
+		require.Equal(t, payments[i].RunOn, occ.RunOn)
+		require.Equal(t, moov.ScheduleAmount{Currency: "USD", Value: payments[i].Payment}, occ.RunTransfer.Amount)
+		require.Equal(t, accounts.Source, occ.RunTransfer.Source)
+		require.Equal(t, accounts.Destination, occ.RunTransfer.Destination)
+		total += occ.RunTransfer.Amount.Value
+	}
+	require.Equal(t, principal.Value+interest, total)
+	require.Equal(t, "Loan payment 1 of 12: principal 810.66 USD, interest 50.00 USD", schedule.Occurrences[0].RunTransfer.Description)
+
+	_, err = moov.BuildAmortizedSchedule(principal, 6, 0, start, accounts)
+	require.Error(t, err)
+	_, err = moov.BuildAmortizedSchedule(moov.Amount{Currency: "USD"}, 6, 12, start, accounts)
+	require.ErrorIs(t, err, moov.ErrInvalidAmount)
+	_, err = moov.BuildAmortizedSchedule(moov.Amount{Currency: "USD", Value: 11}, 6, 12, start, accounts)
+	require.ErrorIs(t, err, moov.ErrInvalidAmount)
+	_, err = moov.BuildAmortizedSchedule(moov.Amount{Currency: "US", Value: 100_00}, 6, 12, start, accounts)
+	require.ErrorIs(t, err, moov.ErrInvalidAmount)
+
+	// Payments can't round away to nothing before the end of the term
+	_, err = moov.Amortize(moov.Amount{Currency: "USD", Value: 100}, 6, 36, start)
+	require.ErrorIs(t, err, moov.ErrInvalidAmount)
+
+	// Currency codes are uppercased as Moov expects
+	schedule, err = moov.BuildAmortizedSchedule(moov.Amount{Currency: "usd", Value: 100_00}, 6, 12, start, accounts)
+	require.NoError(t, err)
+	require.Equal(t, "USD", schedule.Occurrences[0].RunTransfer.Amount.Currency)
+
+	// Without interest the principal is split evenly with the remainder in the last payment
+	payments, err = moov.Amortize(moov.Amount{Currency: "USD", Value: 100_00}, 0, 3, start)
+	require.NoError(t, err)
+	require.Equal(t, []int64{33_33, 33_33, 33_34}, []int64{payments[0].Payment, payments[1].Payment, payments[2].Payment})
+}
+
+func Test_CancelOccurrencesAfter(t *testing.T) {
+	first := time.Date(2040, time.January, 15, 0, 0, 0, 0, time.UTC)
+	payoff := first.AddDate(0, 23, 0)
+
+	// A 36 month loan with the first year of installments already ran
+	schedule := moov.Schedule{ScheduleID: "schedule-id", Description: "Car loan"}
+	for i := range 36 {
+		occ := moov.Occurrence{
+			OccurrenceID: fmt.Sprintf("installment-%02d", i+1),
+			RunOn:        first.AddDate(0, i, 0),
+		}
+		if i < 12 {
+			ranOn, status := occ.RunOn, string(moov.OccurrenceStatus_Completed)
+			occ.RanOn, occ.Status = &ranOn, &status
+		}
+		if i == 30 {
+			occ.CanceledOn = &first
+		}
+		schedule.Occurrences = append(schedule.Occurrences, occ)
+	}
+
+	var updates []moov.UpdateSchedule
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/accounts/partner-id/schedules/schedule-id" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			writeJson(t, w, http.StatusOK, schedule)
+		case http.MethodPut:
+			var update moov.UpdateSchedule
+			if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+				t.Errorf("decoding update: %v", err)
+			}
+			updates = append(updates, update)
+
+			for _, upd := range update.Occurrences {
+				for i := range schedule.Occurrences {
+					if schedule.Occurrences[i].OccurrenceID == *upd.OccurrenceID && upd.Canceled != nil && *upd.Canceled {
+						schedule.Occurrences[i].CanceledOn = &payoff
+					}
+				}
+			}
+			writeJson(t, w, http.StatusOK, schedule)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	result, err := mc.CancelOccurrencesAfter(BgCtx(), "partner-id", "schedule-id", payoff)
+	require.NoError(t, err)
+
+	// Installments 25 through 36 run after the payoff, less the one already canceled, and go in one update
+	require.Len(t, updates, 1)
+	require.Equal(t, "Car loan", updates[0].Description)
+
+	// Every occurrence is sent back, with only those after the payoff changed
+	require.Len(t, updates[0].Occurrences, 36)
+
+	var canceled []string
+	for _, occ := range updates[0].Occurrences {
+		if occ.Canceled != nil {
+			require.True(t, *occ.Canceled)
+			canceled = append(canceled, *occ.OccurrenceID)
+		}
+	}
+	require.Len(t, canceled, 11)
+	require.Equal(t, "installment-25", canceled[0])
+	require.Equal(t, "installment-36", canceled[len(canceled)-1])
+	require.NotContains(t, canceled, "installment-24")
+	require.NotContains(t, canceled, "installment-31")
+
+	for i, occ := range result.Occurrences {
+		switch {
+		case i < 12:
+			require.Equal(t, moov.OccurrenceStatus_Completed, occ.CurrentStatus(), occ.OccurrenceID)
+		case i < 24:
+			require.Equal(t, moov.OccurrenceStatus_Scheduled, occ.CurrentStatus(), occ.OccurrenceID)
+		default:
+			require.Equal(t, moov.OccurrenceStatus_Canceled, occ.CurrentStatus(), occ.OccurrenceID)
+		}
+	}
+
+	// Nothing left to cancel, so nothing's updated
+	_, err = mc.CancelOccurrencesAfter(BgCtx(), "partner-id", "schedule-id", payoff)
+	require.NoError(t, err)
+	require.Len(t, updates, 1)
+}
@@ -0,0 +1,152 @@
+package moov
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// SettlementReport totals the completed money movement of an account over a calendar day.
+type SettlementReport struct {
+	AccountID string
+
+	// Start and end of the day the report covers. Start is inclusive while End is exclusive.
+	Start time.Time
+	End   time.Time
+
+	// Number of transfers completed during the day.
+	TransferCount int
+
+	// Sum of the amounts of completed transfers paid to the account.
+	Credits Amount
+	// Sum of the amounts of completed transfers paid by the account.
+	Debits Amount
+	// Sum of the Moov fees charged for the completed transfers.
+	Fees Amount
+	// Sum of the completed refunds issued during the day.
+	Refunds Amount
+	// Credits minus Debits, Fees, and Refunds.
+	Net Amount
+}
+
+// How far before the day transfers are listed from. Transfers complete, and refunds are issued, some time after the
+// transfer was created, so those created up to this long before the day are checked for completing during it.
+const (
+	settlementCompletedLookback = 14 * 24 * time.Hour
+	settlementRefundsLookback   = 180 * 24 * time.Hour
+)
+
+// SettlementReport pulls the transfers completed, the refunds issued, and the fees charged during the calendar day
+// containing `day`. The day's boundaries are taken from the location of `day`, so pass a time in the timezone the report
+// should respect. Transfers that took longer than two weeks to complete, or refunds issued more than 180 days after
+// their transfer, aren't counted.
+func (c Client) SettlementReport(ctx context.Context, accountID string, day time.Time) (*SettlementReport, error) {
+	start := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+	end := start.AddDate(0, 0, 1)
+
+	report := &SettlementReport{
+		AccountID: accountID,
+		Start:     start,
+		End:       end,
+	}
+
+	inDay := func(t time.Time) bool {
+		return !t.Before(start) && t.Before(end)
+	}
+
+	candidates, err := listAllPages(func(skip, count int) ([]Transfer, error) {
+		return c.ListTransfers(ctx, accountID,
+			WithTransferStatus(string(TransferStatus_Completed)),
+			WithTransferStartDate(start.Add(-settlementCompletedLookback)),
+			WithTransferEndDate(end),
+			WithTransferCount(count),
+			WithTransferSkip(skip))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var credits, debits, completed []Transfer
+	for _, transfer := range candidates {
+		completedOn := transfer.CreatedOn
+		if transfer.CompletedOn != nil {
+			completedOn = *transfer.CompletedOn
+		}
+		if transfer.Status != TransferStatus_Completed || !inDay(completedOn) {
+			continue
+		}
+
+		completed = append(completed, transfer)
+		if transfer.Destination.Account.AccountID == accountID {
+			credits = append(credits, transfer)
+		}
+		if transfer.Source.Account.AccountID == accountID {
+			debits = append(debits, transfer)
+		}
+	}
+
+	refunded, err := listAllPages(func(skip, count int) ([]Transfer, error) {
+		return c.ListTransfers(ctx, accountID,
+			WithTransferRefunded(),
+			WithTransferStartDate(start.Add(-settlementRefundsLookback)),
+			WithTransferEndDate(end),
+			WithTransferCount(count),
+			WithTransferSkip(skip))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var refunds []Refund
+	for _, transfer := range refunded {
+		for _, refund := range transfer.Refunds {
+			if refund.Status == RefundStatus_Completed && inDay(refund.CreatedOn) {
+				refunds = append(refunds, refund)
+			}
+		}
+	}
+
+	report.TransferCount = len(completed)
+	if report.Credits, err = SumAmounts(credits, func(t Transfer) Amount { return t.Amount }); err != nil {
+		return nil, fmt.Errorf("settlement report: %w", err)
+	}
+	if report.Debits, err = SumAmounts(debits, func(t Transfer) Amount { return t.Amount }); err != nil {
+		return nil, fmt.Errorf("settlement report: %w", err)
+	}
+	if report.Fees, err = SumAmounts(completed, transferMoovFee); err != nil {
+		return nil, fmt.Errorf("settlement report: %w", err)
+	}
+	if report.Refunds, err = SumAmounts(refunds, func(r Refund) Amount { return r.Amount }); err != nil {
+		return nil, fmt.Errorf("settlement report: %w", err)
+	}
+
+	// Any of the totals could have nothing in the day, leaving it without a currency
+	totals := []*Amount{&report.Credits, &report.Debits, &report.Fees, &report.Refunds, &report.Net}
+
+	var currency string
+	for _, amount := range totals {
+		switch {
+		case amount.Currency == "":
+		case currency == "":
+			currency = amount.Currency
+		case amount.Currency != currency:
+			return nil, fmt.Errorf("%w: settlement report found %s and %s", ErrCurrencyMismatch, currency, amount.Currency)
+		}
+	}
+
+	report.Net.Value = report.Credits.Value - report.Debits.Value - report.Fees.Value - report.Refunds.Value
+	for _, amount := range totals {
+		amount.Currency = currency
+	}
+
+	return report, nil
+}
+
+// transferMoovFee returns the Moov fee charged for the transfer in the transfer's currency
+func transferMoovFee(t Transfer) Amount {
+	fee := Amount{Currency: t.Amount.Currency}
+	if t.MoovFee != nil {
+		fee.Value = *t.MoovFee
+	}
+	return fee
+}
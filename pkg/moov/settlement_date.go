@@ -0,0 +1,182 @@
+package moov
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Latest time of day, Eastern Time, a transfer can be created to be processed that banking day. These are estimates
+// with some margin before the Federal Reserve's processing windows close.
+const (
+	sameDayAchCutoff  = 15*time.Hour + 30*time.Minute
+	standardAchCutoff = 20 * time.Hour
+)
+
+// Banking days after the processing day until funds settle
+const (
+	standardAchSettlementDays = 2
+	cardSettlementDays        = 1
+)
+
+// EstimateSettlement estimates when the funds of a transfer created at createdOn over the rail settle, for showing a
+// "funds by" date. It's an estimate: Moov and the banks involved can settle sooner or later.
+//
+// ACH transfers created on a banking day before the cutoff are processed that day, otherwise on the next banking day.
+// Same-day ACH settles on the processing day while standard ACH settles two banking days later. Card payments settle
+// to the Moov wallet the banking day after they're made. For these rails the start of the settlement day in Eastern
+// Time is returned. RTP and wallet transfers settle instantly, so createdOn is returned for them and any other rail.
+func EstimateSettlement(rail Rail, createdOn time.Time, sameDay bool) time.Time {
+	created := createdOn.In(easternTime(createdOn))
+	// Banking days are counted by their date, carried at midnight UTC so adding days isn't affected by daylight time
+	day := time.Date(created.Year(), created.Month(), created.Day(), 0, 0, 0, 0, time.UTC)
+	sinceMidnight := created.Sub(time.Date(created.Year(), created.Month(), created.Day(), 0, 0, 0, 0, created.Location()))
+
+	switch rail {
+	case RailAch:
+		cutoff := standardAchCutoff
+		if sameDay {
+			cutoff = sameDayAchCutoff
+		}
+		if !isBankingDate(day) || sinceMidnight >= cutoff {
+			day = addBankingDays(day, 1)
+		}
+
+		if !sameDay {
+			day = addBankingDays(day, standardAchSettlementDays)
+		}
+		return startOfEasternDay(day)
+	case RailCard:
+		return startOfEasternDay(addBankingDays(day, cardSettlementDays))
+	default:
+		return createdOn
+	}
+}
+
+// EstimatedSettlement estimates when the transfer's funds settle from its rail and status, see EstimateSettlement.
+// Completed transfers return when they completed. ErrTransferNotSettling is returned for transfers that failed, were
+// canceled, or were reversed.
+func (c Client) EstimatedSettlement(ctx context.Context, accountID, transferID string) (time.Time, error) {
+	transfer, err := c.GetTransfer(ctx, accountID, transferID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	switch transfer.Status {
+	case TransferStatus_Completed:
+		if transfer.CompletedOn != nil {
+			return *transfer.CompletedOn, nil
+		}
+	case TransferStatus_Failed, TransferStatus_Canceled, TransferStatus_Reversed:
+		return time.Time{}, fmt.Errorf("%w: transfer %s is %s", ErrTransferNotSettling, transferID, transfer.Status)
+	}
+
+	source := transfer.Source.PaymentMethodType
+	destination := transfer.Destination.PaymentMethodType
+
+	switch {
+	case destination == PaymentMethodType_PushToCard:
+		// Pushed funds are available to the cardholder within minutes
+		return transfer.CreatedOn, nil
+	case source.Rail() == RailCard:
+		return EstimateSettlement(RailCard, transfer.CreatedOn, false), nil
+	case destination.Rail() == RailAch:
+		return EstimateSettlement(RailAch, transfer.CreatedOn, destination == PaymentMethodType_AchCreditSameDay), nil
+	case source.Rail() == RailAch:
+		return EstimateSettlement(RailAch, transfer.CreatedOn, false), nil
+	default:
+		return EstimateSettlement(destination.Rail(), transfer.CreatedOn, false), nil
+	}
+}
+
+// IsBankingDay reports if the date of t in Eastern Time is a day the Federal Reserve processes payments, a weekday
+// that isn't a Federal Reserve holiday.
+func IsBankingDay(t time.Time) bool {
+	t = t.In(easternTime(t))
+	return isBankingDate(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC))
+}
+
+func isBankingDate(date time.Time) bool {
+	switch date.Weekday() {
+	case time.Saturday, time.Sunday:
+		return false
+	}
+	return !isFederalReserveHoliday(date.Year(), date.Month(), date.Day(), date.Weekday())
+}
+
+// addBankingDays returns the date of the nth banking day after date
+func addBankingDays(date time.Time, n int) time.Time {
+	for n > 0 {
+		date = date.AddDate(0, 0, 1)
+		if isBankingDate(date) {
+			n--
+		}
+	}
+	return date
+}
+
+// startOfEasternDay returns midnight Eastern Time at the start of the date
+func startOfEasternDay(date time.Time) time.Time {
+	year, month, day := date.Date()
+	noon := time.Date(year, month, day, 12, 0, 0, 0, time.UTC)
+	return time.Date(year, month, day, 0, 0, 0, 0, easternTime(noon))
+}
+
+// isFederalReserveHoliday reports if the weekday is a holiday the Federal Reserve observes. Holidays on a Sunday are
+// observed the following Monday, but those on a Saturday aren't observed on the Friday before.
+func isFederalReserveHoliday(year int, month time.Month, day int, weekday time.Weekday) bool {
+	fixed := func(m time.Month, d int) bool {
+		if month != m {
+			return false
+		}
+		return day == d || (weekday == time.Monday && day == d+1)
+	}
+	nthWeekday := func(m time.Month, wd time.Weekday, n int) bool {
+		return month == m && weekday == wd && (day-1)/7 == n-1
+	}
+	lastWeekday := func(m time.Month, wd time.Weekday) bool {
+		return month == m && weekday == wd && day+7 > time.Date(year, m+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	}
+
+	switch {
+	case fixed(time.January, 1), // New Year's Day
+		nthWeekday(time.January, time.Monday, 3),    // Martin Luther King Jr. Day
+		nthWeekday(time.February, time.Monday, 3),   // Washington's Birthday
+		lastWeekday(time.May, time.Monday),          // Memorial Day
+		year >= 2022 && fixed(time.June, 19),        // Juneteenth
+		fixed(time.July, 4),                         // Independence Day
+		nthWeekday(time.September, time.Monday, 1),  // Labor Day
+		nthWeekday(time.October, time.Monday, 2),    // Columbus Day
+		fixed(time.November, 11),                    // Veterans Day
+		nthWeekday(time.November, time.Thursday, 4), // Thanksgiving Day
+		fixed(time.December, 25):                    // Christmas Day
+		return true
+	default:
+		return false
+	}
+}
+
+var (
+	easternStandardTime = time.FixedZone("EST", -5*60*60)
+	easternDaylightTime = time.FixedZone("EDT", -4*60*60)
+)
+
+// easternTime returns the US Eastern Time zone in effect at t. Daylight time runs from 2 AM on the second Sunday in
+// March to 2 AM on the first Sunday in November.
+func easternTime(t time.Time) *time.Location {
+	year := t.UTC().Year()
+	start := nthSunday(year, time.March, 2).Add(2*time.Hour + 5*time.Hour)
+	end := nthSunday(year, time.November, 1).Add(2*time.Hour + 4*time.Hour)
+
+	if !t.Before(start) && t.Before(end) {
+		return easternDaylightTime
+	}
+	return easternStandardTime
+}
+
+// nthSunday returns midnight UTC of the nth Sunday of the month
+func nthSunday(year int, month time.Month, n int) time.Time {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	offset := (7 - int(first.Weekday())) % 7
+	return first.AddDate(0, 0, offset+7*(n-1))
+}
@@ -0,0 +1,240 @@
+package moov_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/moovfinancial/moov-go/pkg/moov"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SettlementReport(t *testing.T) {
+	eastern := time.FixedZone("EST", -5*60*60)
+	day := time.Date(2024, time.January, 10, 15, 0, 0, 0, eastern)
+
+	transfer := func(id string, completedOn time.Time, value int64, fee int64) moov.Transfer {
+		return moov.Transfer{
+			TransferID:  id,
+			Status:      moov.TransferStatus_Completed,
+			CreatedOn:   completedOn.Add(-time.Minute),
+			CompletedOn: &completedOn,
+			Amount:      moov.Amount{Currency: "USD", Value: value},
+			Destination: moov.TransferDestination{Account: moov.TransferAccount{AccountID: "account-id"}},
+			MoovFee:     &fee,
+		}
+	}
+
+	// Created days before, but completed on the 10th
+	ach := transfer("ach", time.Date(2024, time.January, 10, 18, 0, 0, 0, time.UTC), 6000, 60)
+	ach.CreatedOn = time.Date(2024, time.January, 7, 18, 0, 0, 0, time.UTC)
+
+	// Paid by the account rather than to it
+	payout := transfer("payout", time.Date(2024, time.January, 10, 19, 0, 0, 0, time.UTC), 1500, 15)
+	payout.Source.Account.AccountID = "account-id"
+	payout.Destination.Account.AccountID = "other-account-id"
+
+	completed := []moov.Transfer{
+		// 11:59pm eastern the day before, but already the 10th in UTC
+		transfer("before-midnight", time.Date(2024, time.January, 10, 4, 59, 0, 0, time.UTC), 1000, 10),
+		// first minute of the 10th in eastern
+		transfer("after-midnight", time.Date(2024, time.January, 10, 5, 0, 0, 0, time.UTC), 2000, 20),
+		// last minute of the 10th in eastern, but the 11th in UTC
+		transfer("end-of-day", time.Date(2024, time.January, 11, 4, 59, 0, 0, time.UTC), 3000, 30),
+		// the 11th in eastern
+		transfer("next-day", time.Date(2024, time.January, 11, 5, 0, 0, 0, time.UTC), 4000, 40),
+		ach,
+		payout,
+	}
+
+	// Refunded on the 10th, months after it completed
+	refunded := transfer("refunded", time.Date(2023, time.October, 2, 12, 0, 0, 0, time.UTC), 900, 9)
+	refunded.Refunds = []moov.Refund{
+		{RefundID: "refund", Status: moov.RefundStatus_Completed, CreatedOn: time.Date(2024, time.January, 10, 20, 0, 0, 0, time.UTC), Amount: moov.Amount{Currency: "USD", Value: 500}},
+		{RefundID: "pending-refund", Status: moov.RefundStatus_Pending, CreatedOn: time.Date(2024, time.January, 10, 20, 0, 0, 0, time.UTC), Amount: moov.Amount{Currency: "USD", Value: 700}},
+		{RefundID: "earlier-refund", Status: moov.RefundStatus_Completed, CreatedOn: time.Date(2023, time.December, 1, 20, 0, 0, 0, time.UTC), Amount: moov.Amount{Currency: "USD", Value: 100}},
+	}
+
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		require.Equal(t, "/accounts/account-id/transfers", r.URL.Path)
+		require.Equal(t, "2024-01-11T00:00:00-05:00", query.Get("endDateTime"))
+
+		if query.Get("refunded") == "true" {
+			require.Equal(t, "2023-07-14T00:00:00-05:00", query.Get("startDateTime"))
+			writeJson(t, w, http.StatusOK, []moov.Transfer{refunded})
+			return
+		}
+
+		require.Equal(t, "completed", query.Get("status"))
+		require.Equal(t, "2023-12-27T00:00:00-05:00", query.Get("startDateTime"))
+		writeJson(t, w, http.StatusOK, completed)
+	}))
+
+	report, err := mc.SettlementReport(BgCtx(), "account-id", day)
+	require.NoError(t, err)
+
+	require.Equal(t, 4, report.TransferCount)
+	require.Equal(t, moov.Amount{Currency: "USD", Value: 11000}, report.Credits)
+	require.Equal(t, moov.Amount{Currency: "USD", Value: 1500}, report.Debits)
+	require.Equal(t, moov.Amount{Currency: "USD", Value: 125}, report.Fees)
+	require.Equal(t, moov.Amount{Currency: "USD", Value: 500}, report.Refunds)
+	require.Equal(t, moov.Amount{Currency: "USD", Value: 8875}, report.Net)
+
+	t.Run("currency mismatch", func(t *testing.T) {
+		completed[2].Amount.Currency = "CAD"
+
+		_, err := mc.SettlementReport(BgCtx(), "account-id", day)
+		require.ErrorIs(t, err, moov.ErrCurrencyMismatch)
+	})
+}
+
+func Test_EstimateSettlement(t *testing.T) {
+	est := time.FixedZone("EST", -5*60*60)
+	edt := time.FixedZone("EDT", -4*60*60)
+
+	cases := []struct {
+		name      string
+		rail      moov.Rail
+		createdOn time.Time
+		sameDay   bool
+		expected  time.Time
+	}{
+		{
+			name:      "same-day ACH before the cutoff",
+			rail:      moov.RailAch,
+			createdOn: time.Date(2026, time.October, 14, 10, 0, 0, 0, edt),
+			sameDay:   true,
+			expected:  time.Date(2026, time.October, 14, 0, 0, 0, 0, edt),
+		},
+		{
+			name:      "same-day ACH after the cutoff on a Friday waits for Monday",
+			rail:      moov.RailAch,
+			createdOn: time.Date(2026, time.October, 16, 17, 0, 0, 0, edt),
+			sameDay:   true,
+			expected:  time.Date(2026, time.October, 19, 0, 0, 0, 0, edt),
+		},
+		{
+			name:      "Independence Day on a Saturday isn't observed the Friday before",
+			rail:      moov.RailAch,
+			createdOn: time.Date(2026, time.July, 3, 10, 0, 0, 0, edt),
+			sameDay:   true,
+			expected:  time.Date(2026, time.July, 3, 0, 0, 0, 0, edt),
+		},
+		{
+			name:      "Christmas on a Sunday is observed the Monday after",
+			rail:      moov.RailAch,
+			createdOn: time.Date(2022, time.December, 24, 10, 0, 0, 0, est),
+			sameDay:   true,
+			expected:  time.Date(2022, time.December, 27, 0, 0, 0, 0, est),
+		},
+		{
+			name:      "standard ACH skips Thanksgiving and the weekend",
+			rail:      moov.RailAch,
+			createdOn: time.Date(2026, time.November, 25, 10, 0, 0, 0, est),
+			expected:  time.Date(2026, time.November, 30, 0, 0, 0, 0, est),
+		},
+		{
+			name:      "standard ACH created on a weekend",
+			rail:      moov.RailAch,
+			createdOn: time.Date(2026, time.October, 17, 10, 0, 0, 0, edt),
+			expected:  time.Date(2026, time.October, 21, 0, 0, 0, 0, edt),
+		},
+		{
+			name:      "cutoff is in daylight time after the clocks change",
+			rail:      moov.RailAch,
+			createdOn: time.Date(2026, time.March, 9, 19, 45, 0, 0, time.UTC),
+			sameDay:   true,
+			expected:  time.Date(2026, time.March, 10, 0, 0, 0, 0, edt),
+		},
+		{
+			name:      "cutoff is in standard time before the clocks change",
+			rail:      moov.RailAch,
+			createdOn: time.Date(2026, time.March, 6, 19, 45, 0, 0, time.UTC),
+			sameDay:   true,
+			expected:  time.Date(2026, time.March, 6, 0, 0, 0, 0, est),
+		},
+		{
+			name:      "card payment on the Friday before Martin Luther King Jr. Day",
+			rail:      moov.RailCard,
+			createdOn: time.Date(2026, time.January, 16, 22, 0, 0, 0, est),
+			expected:  time.Date(2026, time.January, 20, 0, 0, 0, 0, est),
+		},
+		{
+			name:      "RTP settles instantly",
+			rail:      moov.RailRtp,
+			createdOn: time.Date(2026, time.December, 25, 10, 0, 0, 0, est),
+			expected:  time.Date(2026, time.December, 25, 10, 0, 0, 0, est),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := moov.EstimateSettlement(tc.rail, tc.createdOn, tc.sameDay)
+			require.True(t, tc.expected.Equal(actual), "expected %v, got %v", tc.expected, actual)
+		})
+	}
+
+	require.False(t, moov.IsBankingDay(time.Date(2026, time.May, 25, 12, 0, 0, 0, edt)))  // Memorial Day
+	require.False(t, moov.IsBankingDay(time.Date(2026, time.June, 19, 12, 0, 0, 0, edt))) // Juneteenth
+	require.True(t, moov.IsBankingDay(time.Date(2021, time.June, 18, 12, 0, 0, 0, edt)))
+	// Late Monday night UTC is still Monday in Eastern Time
+	require.False(t, moov.IsBankingDay(time.Date(2026, time.September, 8, 2, 0, 0, 0, time.UTC))) // Labor Day
+}
+
+func Test_EstimatedSettlement(t *testing.T) {
+	createdOn := time.Date(2026, time.November, 25, 10, 0, 0, 0, time.FixedZone("EST", -5*60*60))
+	completedOn := createdOn.AddDate(0, 0, 2)
+
+	transfers := map[string]moov.Transfer{
+		"card": {
+			Status:      moov.TransferStatus_Pending,
+			CreatedOn:   createdOn,
+			Source:      moov.TransferSource{PaymentMethodType: moov.PaymentMethodType_CardPayment},
+			Destination: moov.TransferDestination{PaymentMethodType: moov.PaymentMethodType_MoovWallet},
+		},
+		"same-day": {
+			Status:      moov.TransferStatus_Pending,
+			CreatedOn:   createdOn,
+			Source:      moov.TransferSource{PaymentMethodType: moov.PaymentMethodType_MoovWallet},
+			Destination: moov.TransferDestination{PaymentMethodType: moov.PaymentMethodType_AchCreditSameDay},
+		},
+		"completed": {
+			Status:      moov.TransferStatus_Completed,
+			CreatedOn:   createdOn,
+			CompletedOn: &completedOn,
+		},
+		"failed": {
+			Status:    moov.TransferStatus_Failed,
+			CreatedOn: createdOn,
+		},
+	}
+
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.URL.Path[len("/accounts/account-id/transfers/"):]
+		transfer, ok := transfers[id]
+		if !ok {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		transfer.TransferID = id
+		writeJson(t, w, http.StatusOK, transfer)
+	}))
+
+	// The day after is Thanksgiving
+	settlesOn, err := mc.EstimatedSettlement(BgCtx(), "account-id", "card")
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, time.November, 27, 5, 0, 0, 0, time.UTC), settlesOn.UTC())
+
+	settlesOn, err = mc.EstimatedSettlement(BgCtx(), "account-id", "same-day")
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, time.November, 25, 5, 0, 0, 0, time.UTC), settlesOn.UTC())
+
+	settlesOn, err = mc.EstimatedSettlement(BgCtx(), "account-id", "completed")
+	require.NoError(t, err)
+	require.True(t, completedOn.Equal(settlesOn))
+
+	_, err = mc.EstimatedSettlement(BgCtx(), "account-id", "failed")
+	require.ErrorIs(t, err, moov.ErrTransferNotSettling)
+}
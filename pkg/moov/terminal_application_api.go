@@ -54,10 +54,5 @@ func (c Client) ListTerminalApplications(ctx context.Context) ([]TerminalApplica
 
 // DeleteTerminalApplication deletes a terminal application.
 func (c Client) DeleteTerminalApplication(ctx context.Context, terminalApplicationID string) error {
-	resp, err := c.CallHttp(ctx, Endpoint(http.MethodDelete, pathTerminalApplication, terminalApplicationID))
-	if err != nil {
-		return err
-	}
-
-	return CompletedNilOrError(resp)
+	return c.delete(ctx, Endpoint(http.MethodDelete, pathTerminalApplication, terminalApplicationID))
 }
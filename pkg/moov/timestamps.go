@@ -0,0 +1,49 @@
+package moov
+
+import "time"
+
+// Timestamped is implemented by the resources that record when they were last changed, so they can be compared or
+// synced without knowing which timestamps each resource has. Timestamps are decoded as RFC 3339 with or without
+// fractional seconds.
+type Timestamped interface {
+	// LastModified returns the latest of when the resource was created, updated, or disabled
+	LastModified() time.Time
+}
+
+var (
+	_ Timestamped = Account{}
+	_ Timestamped = BankAccount{}
+	_ Timestamped = Representative{}
+	_ Timestamped = Schedule{}
+)
+
+// LastModified returns the latest of when the account was created, updated, or disconnected
+func (a Account) LastModified() time.Time {
+	return latestOf(a.CreatedOn, a.UpdatedOn, a.DisconnectedOn)
+}
+
+// LastModified returns when the bank account was last updated, the only timestamp Moov returns on bank accounts
+func (b BankAccount) LastModified() time.Time {
+	return b.UpdatedOn
+}
+
+// LastModified returns the latest of when the representative was created, updated, or disabled
+func (r Representative) LastModified() time.Time {
+	return latestOf(r.CreatedOn, r.UpdatedOn, r.DisabledOn)
+}
+
+// LastModified returns the latest of when the schedule was created, updated, or disabled
+func (s Schedule) LastModified() time.Time {
+	return latestOf(s.CreatedOn, s.UpdatedOn, s.DisabledOn)
+}
+
+func latestOf(created, updated time.Time, disabled *time.Time) time.Time {
+	latest := created
+	if updated.After(latest) {
+		latest = updated
+	}
+	if disabled != nil && disabled.After(latest) {
+		latest = *disabled
+	}
+	return latest
+}
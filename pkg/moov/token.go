@@ -2,7 +2,9 @@ package moov
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 )
 
 type accessTokenRequest struct {
@@ -134,3 +136,40 @@ func (c *Client) AccessToken(ctx context.Context, scopes ...ScopeBuilder) (*Acce
 		ClientSecret: &c.Credentials.SecretKey,
 	}, scopes...)
 }
+
+// Creates a short lived access token for Moov.js or other browser based drop-ins. Only scopes for the given account
+// are allowed, such as `Scopes.AccountProfileRead(accountID)` or `Scopes.CardsWrite(accountID)`, so the token handed
+// to the browser can't be used against other accounts or broad endpoints.
+func (c *Client) CreateAccessTokenForMoovJS(ctx context.Context, accountID string, scopes ...ScopeBuilder) (*AccessTokenResponse, error) {
+	if err := validateAccountScopes(accountID, scopes...); err != nil {
+		return nil, err
+	}
+
+	return c.accessToken(ctx, accessTokenRequest{
+		GrantType:    "client_credentials",
+		ClientId:     &c.Credentials.PublicKey,
+		ClientSecret: &c.Credentials.SecretKey,
+	}, scopes...)
+}
+
+func validateAccountScopes(accountID string, scopes ...ScopeBuilder) error {
+	if accountID == "" || len(scopes) == 0 {
+		return fmt.Errorf("%w: an accountID and at least one scope are required", ErrScopeNotAccountScoped)
+	}
+
+	sb := &scopeBuilder{}
+	for _, scp := range scopes {
+		if err := scp(sb); err != nil {
+			return err
+		}
+	}
+
+	prefix := fmt.Sprintf("/accounts/%s/", accountID)
+	for _, scope := range sb.scopes {
+		if !strings.HasPrefix(scope, prefix) {
+			return fmt.Errorf("%w: %s", ErrScopeNotAccountScoped, scope)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package moov
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// AuthError is returned when a call was rejected for an expired or invalid access token and a new token couldn't be
+// used in its place.
+type AuthError struct {
+	Err error
+}
+
+func (e *AuthError) Error() string {
+	return fmt.Sprintf("unable to reauthenticate with moov: %v", e.Err)
+}
+
+func (e *AuthError) Unwrap() error {
+	return e.Err
+}
+
+// Tokens are replaced this long before they expire so they don't expire in flight.
+const accessTokenExpiryMargin = 30 * time.Second
+
+// WithAccessTokenAuth authenticates calls with an access token for the scopes instead of the API keys. The token is
+// cached until it expires. If a call is rejected as unauthenticated the token is replaced and the call retried once,
+// only for calls that are safe to repeat: reads, deletes, and calls with an idempotency key.
+func WithAccessTokenAuth(scopes ...ScopeBuilder) ClientConfigurable {
+	return func(c *Client) error {
+		c.tokenAuth = &tokenAuth{scopes: scopes}
+		return nil
+	}
+}
+
+type tokenAuth struct {
+	scopes []ScopeBuilder
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+// get returns the cached token or fetches a new one if it's missing, expiring, or the same as the rejected token.
+func (t *tokenAuth) get(ctx context.Context, c *Client, rejected string) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.token != "" && t.token != rejected && time.Now().Before(t.expires) {
+		return t.token, nil
+	}
+
+	resp, err := c.AccessToken(ctx, t.scopes...)
+	if err != nil {
+		t.token = ""
+		return "", err
+	}
+
+	t.token = resp.AccessToken
+	t.expires = time.Now().Add(time.Duration(resp.ExpiresIn)*time.Second - accessTokenExpiryMargin)
+	return t.token, nil
+}
+
+func (c *Client) sendWithToken(ctx context.Context, call *callBuilder) (*httpCallResponse, error) {
+	// Keep the body so it can be sent again on a retry
+	var body []byte
+	if call.body != nil {
+		b, err := io.ReadAll(call.body)
+		if err != nil {
+			return nil, err
+		}
+		body = b
+	}
+
+	token, err := c.tokenAuth.get(ctx, c, "")
+	if err != nil {
+		return nil, &AuthError{Err: err}
+	}
+
+	send := func(token string) (*httpCallResponse, error) {
+		call.token = &token
+		if body != nil {
+			call.body = bytes.NewReader(body)
+		}
+		return c.send(ctx, call)
+	}
+
+	resp, err := send(token)
+	if err != nil || resp.Status() != StatusUnauthenticated || !retryable(call) {
+		return resp, err
+	}
+
+	token, err = c.tokenAuth.get(ctx, c, token)
+	if err != nil {
+		return nil, &AuthError{Err: err}
+	}
+
+	resp, err = send(token)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status() == StatusUnauthenticated {
+		return nil, &AuthError{Err: resp}
+	}
+	return resp, nil
+}
+
+// retryable reports if sending the call twice can't have a different effect than sending it once.
+func retryable(call *callBuilder) bool {
+	switch call.method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return call.headers[IdempotencyKeyHeader] != ""
+	}
+}
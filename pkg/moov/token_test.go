@@ -0,0 +1,120 @@
+package moov_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/moovfinancial/moov-go/pkg/moov"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AccessToken_UsesCredentialsHost(t *testing.T) {
+	var request map[string]any
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/oauth2/token", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		writeJson(t, w, http.StatusOK, moov.AccessTokenResponse{
+			AccessToken: "access-token",
+			TokenType:   "Bearer",
+			ExpiresIn:   3600,
+			Scope:       "/ping.read",
+		})
+	}))
+
+	token, err := mc.PingAccessToken(BgCtx())
+	require.NoError(t, err)
+	require.Equal(t, "access-token", token.AccessToken)
+	require.Equal(t, "client_credentials", request["grant_type"])
+	require.Equal(t, "/ping.read", request["scope"])
+}
+
+func Test_CreateAccessTokenForMoovJS(t *testing.T) {
+	var request map[string]any
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/oauth2/token", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		writeJson(t, w, http.StatusOK, moov.AccessTokenResponse{AccessToken: "browser-token"})
+	}))
+
+	token, err := mc.CreateAccessTokenForMoovJS(BgCtx(), "account-id",
+		moov.Scopes.AccountProfileRead("account-id"),
+		moov.Scopes.CardsWrite("account-id"))
+	require.NoError(t, err)
+	require.Equal(t, "browser-token", token.AccessToken)
+	require.Equal(t, "/accounts/account-id/profile.read /accounts/account-id/cards.write", request["scope"])
+
+	t.Run("rejects broad scopes", func(t *testing.T) {
+		request = nil
+
+		_, err := mc.CreateAccessTokenForMoovJS(BgCtx(), "account-id",
+			moov.Scopes.CardsRead("account-id"),
+			moov.Scopes.AccountsRead())
+		require.ErrorIs(t, err, moov.ErrScopeNotAccountScoped)
+
+		_, err = mc.CreateAccessTokenForMoovJS(BgCtx(), "account-id", moov.Scopes.CardsRead("other-account-id"))
+		require.ErrorIs(t, err, moov.ErrScopeNotAccountScoped)
+
+		_, err = mc.CreateAccessTokenForMoovJS(BgCtx(), "account-id")
+		require.ErrorIs(t, err, moov.ErrScopeNotAccountScoped)
+
+		require.Nil(t, request)
+	})
+}
+
+func Test_AccessTokenAuth_Reauthenticates(t *testing.T) {
+	var mu sync.Mutex
+	issued := 0
+	failTokens := false
+
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.URL.Path {
+		case "/oauth2/token":
+			if failTokens {
+				writeJson(t, w, http.StatusUnauthorized, map[string]string{"error": "invalid client"})
+				return
+			}
+			issued++
+			writeJson(t, w, http.StatusOK, moov.AccessTokenResponse{AccessToken: fmt.Sprintf("token-%d", issued), ExpiresIn: 3600})
+		case "/ping":
+			// The first token expired while it was cached
+			if r.Header.Get("Authorization") != fmt.Sprintf("Bearer token-%d", issued) || issued == 1 {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}), moov.WithAccessTokenAuth(moov.Scopes.Ping()))
+
+	require.NoError(t, mc.Ping(BgCtx()))
+	require.Equal(t, 2, issued)
+
+	// The fresh token is cached
+	require.NoError(t, mc.Ping(BgCtx()))
+	require.Equal(t, 2, issued)
+
+	t.Run("reauth fails", func(t *testing.T) {
+		mu.Lock()
+		issued = 0
+		failTokens = true
+		mu.Unlock()
+
+		// Replacing the cached token fails after it's rejected
+		err := mc.Ping(BgCtx())
+
+		var authErr *moov.AuthError
+		require.ErrorAs(t, err, &authErr)
+		require.Equal(t, moov.StatusUnauthenticated, moov.ErrorAsCallResponse(err).Status())
+	})
+}
@@ -0,0 +1,167 @@
+package moov
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httputil"
+	"strings"
+	"sync"
+)
+
+const redacted = "[REDACTED]"
+
+// Headers that carry credentials and are never written to a trace.
+var redactedHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "X-Api-Key"}
+
+// JSON fields holding card, bank, identity, or token values that are masked in traced bodies.
+var redactedFields = map[string]bool{
+	"accountNumber": true,
+	"cardNumber":    true,
+	"cardCvv":       true,
+	"cvv":           true,
+	"full":          true, // ssn and itin
+	"access_token":  true,
+	"refresh_token": true,
+	"token":         true,
+}
+
+// WithHTTPTrace writes a dump of every request and response to w with credentials and sensitive fields redacted.
+// Useful when sharing the exact calls made with Moov support. Off by default.
+func WithHTTPTrace(w io.Writer) ClientConfigurable {
+	return func(c *Client) error {
+		c.trace = &httpTrace{w: w}
+		return nil
+	}
+}
+
+type httpTrace struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// request dumps req, headed by the name of the operation it's for, while leaving its body unread for the actual call.
+func (t *httpTrace) request(operation string, req *http.Request) error {
+	var body []byte
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		if err != nil {
+			return err
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(b))
+		// Redirects and retries of the transport read the body again through GetBody
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(b)), nil
+		}
+		body = b
+	}
+
+	traced := req.Clone(req.Context())
+	traced.Header = redactHeaders(req.Header)
+	traced.Body, traced.ContentLength = redactedBody(req.Header.Get("Content-Type"), body)
+
+	dump, err := httputil.DumpRequestOut(traced, true)
+	if err != nil {
+		return err
+	}
+	return t.write(append([]byte("# "+operation+"\n"), dump...))
+}
+
+// response dumps resp using the body that's already been read from it.
+func (t *httpTrace) response(resp *http.Response, body []byte) error {
+	traced := *resp
+	traced.Header = redactHeaders(resp.Header)
+	traced.Body, traced.ContentLength = redactedBody(resp.Header.Get("Content-Type"), body)
+
+	dump, err := httputil.DumpResponse(&traced, true)
+	if err != nil {
+		return err
+	}
+	return t.write(dump)
+}
+
+func (t *httpTrace) write(dump []byte) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	_, err := t.w.Write(append(dump, '\n', '\n'))
+	return err
+}
+
+func redactHeaders(h http.Header) http.Header {
+	h = h.Clone()
+	for _, name := range redactedHeaders {
+		if h.Get(name) != "" {
+			h.Set(name, redacted)
+		}
+	}
+	return h
+}
+
+func redactedBody(contentType string, body []byte) (io.ReadCloser, int64) {
+	if len(body) == 0 {
+		return http.NoBody, 0
+	}
+
+	body = summarizedBody(contentType, body)
+	return io.NopCloser(bytes.NewReader(body)), int64(len(body))
+}
+
+// redactJSON masks the sensitive fields of a JSON body. Bodies that aren't JSON are returned as they are.
+func redactJSON(body []byte) []byte {
+	var v any
+	if err := json.Unmarshal(body, &v); err == nil {
+		if b, err := json.Marshal(redactValue(v)); err == nil {
+			return b
+		}
+	}
+	return body
+}
+
+// summarizedBody returns the body as it's safe to log: JSON with sensitive fields redacted, and multipart bodies, which
+// carry uploaded files, reduced to the name and size of each part.
+func summarizedBody(contentType string, body []byte) []byte {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || !strings.HasPrefix(mediaType, "multipart/") {
+		return redactJSON(body)
+	}
+
+	var parts []string
+	r := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+	for {
+		part, err := r.NextPart()
+		if err != nil {
+			break
+		}
+		size, _ := io.Copy(io.Discard, part)
+		name := part.FormName()
+		if filename := part.FileName(); filename != "" {
+			name += fmt.Sprintf(" (%s, %s)", filename, part.Header.Get("Content-Type"))
+		}
+		parts = append(parts, fmt.Sprintf("%s: %d bytes", name, size))
+	}
+	return []byte(fmt.Sprintf("[%s body, %d bytes: %s]", mediaType, len(body), strings.Join(parts, "; ")))
+}
+
+func redactValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, item := range v {
+			if redactedFields[k] {
+				v[k] = redacted
+			} else {
+				v[k] = redactValue(item)
+			}
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+	return v
+}
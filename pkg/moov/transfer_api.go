@@ -3,7 +3,10 @@ package moov
 import (
 	"context"
 	"errors"
+	"fmt"
+	"maps"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -23,9 +26,46 @@ func WithTransferIdempotencyKey(key uuid.UUID) CreateTransferArgs {
 	}
 }
 
+// WithTransferOperationID reuses the idempotency key of earlier attempts at the same operation, as remembered by the
+// client's IdempotencyStore, so retrying a transfer can't create it twice.
+func WithTransferOperationID(operationID string) CreateTransferArgs {
+	return func(t *createTransferBuilder) callArg {
+		return withOperationID(operationID)
+	}
+}
+
+// WithoutTransferIdempotencyKey creates the transfer without an idempotency key, see WithoutIdempotencyKey.
+func WithoutTransferIdempotencyKey() CreateTransferArgs {
+	return func(t *createTransferBuilder) callArg {
+		return WithoutIdempotencyKey()
+	}
+}
+
+// WithTransferActingAccount sends the transfer on behalf of the partner account it's created under, see
+// WithActingAccount. The partner's account ID must be a UUID.
+func WithTransferActingAccount(partnerAccountID string) CreateTransferArgs {
+	return func(t *createTransferBuilder) callArg {
+		return WithActingAccount(partnerAccountID)
+	}
+}
+
+// WithTransferTimeout limits how long creating the transfer can take, such as allowing WaitForRailResponse longer than
+// other calls. It can't extend the deadline of the context passed to CreateTransfer.
+func WithTransferTimeout(timeout time.Duration) CreateTransferArgs {
+	return func(t *createTransferBuilder) callArg {
+		return WithTimeout(timeout)
+	}
+}
+
 // CreateTransfer creates a new transfer
 // https://docs.moov.io/api/index.html#tag/Transfers/operation/createTransfer
 func (c Client) CreateTransfer(ctx context.Context, partnerAccountID string, transfer CreateTransfer, options ...CreateTransferArgs) CreateTransferBuilder {
+	transfer.Amount = c.withDefaultCurrency(transfer.Amount)
+	if transfer.SalesTaxAmount != nil {
+		salesTax := c.withDefaultCurrency(*transfer.SalesTaxAmount)
+		transfer.SalesTaxAmount = &salesTax
+	}
+
 	builder := &createTransferBuilder{}
 	callArgs := []callArg{
 		AcceptJson(),
@@ -38,22 +78,32 @@ func (c Client) CreateTransfer(ctx context.Context, partnerAccountID string, tra
 	}
 
 	return CreateTransferBuilder{
-		client:   c,
-		ctx:      ctx,
-		endpoint: Endpoint(http.MethodPost, pathTransfers, partnerAccountID),
-		callArgs: callArgs,
+		client:    c,
+		ctx:       ctx,
+		accountID: partnerAccountID,
+		endpoint:  Endpoint(http.MethodPost, pathTransfers, partnerAccountID),
+		callArgs:  callArgs,
+		err:       errors.Join(transfer.validate(), c.transferLimits.check(transfer.Amount)),
 	}
 }
 
 type CreateTransferBuilder struct {
-	client   Client
-	ctx      context.Context
-	endpoint EndpointArg
-	callArgs []callArg
+	client    Client
+	ctx       context.Context
+	accountID string
+	endpoint  EndpointArg
+	callArgs  []callArg
+
+	// Set when the transfer was rejected before being sent
+	err error
 }
 
 // Started initiates the transfers request and doesn't wait beyond creating the transfer
 func (r CreateTransferBuilder) Started() (*TransferStarted, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
 	resp, err := r.client.CallHttp(r.ctx, r.endpoint, r.callArgs...)
 	if err != nil {
 		return nil, err
@@ -62,6 +112,9 @@ func (r CreateTransferBuilder) Started() (*TransferStarted, error) {
 	switch resp.Status() {
 	case StatusCompleted:
 		st, err := UnmarshalObjectResponse[TransferStarted](resp)
+		if st != nil {
+			st.accountID = r.accountID
+		}
 		return st, err
 	case StatusStateConflict:
 		return nil, errors.Join(ErrXIdempotencyKey, resp)
@@ -76,6 +129,10 @@ func (r CreateTransferBuilder) Started() (*TransferStarted, error) {
 // 2) A transfer that started but the request timed out waiting for a response from the rail.
 // 3) An error attempting to create the transfer.
 func (r CreateTransferBuilder) WaitForRailResponse() (*Transfer, *TransferStarted, error) {
+	if r.err != nil {
+		return nil, nil, r.err
+	}
+
 	resp, err := r.client.CallHttp(r.ctx, r.endpoint, append(r.callArgs, WaitFor("rail-response"))...)
 	if err != nil {
 		return nil, nil, err
@@ -87,6 +144,9 @@ func (r CreateTransferBuilder) WaitForRailResponse() (*Transfer, *TransferStarte
 		return transfer, nil, err
 	case StatusStarted:
 		transferStarted, err := UnmarshalObjectResponse[TransferStarted](resp)
+		if transferStarted != nil {
+			transferStarted.accountID = r.accountID
+		}
 		return nil, transferStarted, err
 	case StatusStateConflict:
 		return nil, nil, errors.Join(ErrXIdempotencyKey, resp)
@@ -139,6 +199,15 @@ func WithTransferSchedule(scheduleID string) ListTransferFilter {
 	})
 }
 
+// Fields transfers can be ordered by
+var transferOrderByFields = []string{"createdOn"}
+
+// WithTransferOrderBy sorts the transfers by field. Transfers are ordered by createdOn descending by default so paging
+// through them is stable.
+func WithTransferOrderBy(field string, desc bool) ListTransferFilter {
+	return orderBy(field, desc, transferOrderByFields...)
+}
+
 func WithTransferRefunded() ListTransferFilter {
 	return callBuilderFn(func(call *callBuilder) error {
 		call.params["refunded"] = "true"
@@ -166,7 +235,7 @@ func WithTransferCount(count int) ListTransferFilter {
 func (c Client) ListTransfers(ctx context.Context, accountID string, filters ...ListTransferFilter) ([]Transfer, error) {
 	resp, err := c.CallHttp(ctx,
 		Endpoint(http.MethodGet, pathTransfers, accountID),
-		prependArgs(filters, AcceptJson())...)
+		prependArgs(filters, AcceptJson(), WithTransferOrderBy("createdOn", true))...)
 	if err != nil {
 		return nil, err
 	}
@@ -174,6 +243,60 @@ func (c Client) ListTransfers(ctx context.Context, accountID string, filters ...
 	return CompletedListOrError[Transfer](resp)
 }
 
+// ListAccountTransfers lists the transfers the account is a party to. It's ListTransfers scoped to the account's own
+// endpoint and filtered to the account, which is the most common listing for a connected account.
+func (c Client) ListAccountTransfers(ctx context.Context, accountID string, filters ...ListTransferFilter) ([]Transfer, error) {
+	return c.ListTransfers(ctx, accountID, append([]ListTransferFilter{WithTransferAccountIDs([]string{accountID})}, filters...)...)
+}
+
+// ListActionableTransfers is the work queue of the account's transfers that need someone to act on them, those that are
+// disputed and those that failed for a reason that can be retried. Each transfer is only listed once.
+func (c Client) ListActionableTransfers(ctx context.Context, accountID string) ([]Transfer, error) {
+	queries := [][]ListTransferFilter{
+		{WithTransferDisputed()},
+		{WithTransferStatus(string(TransferStatus_Failed))},
+	}
+
+	var actionable []Transfer
+	seen := map[string]bool{}
+
+	for _, filters := range queries {
+		transfers, err := listAllPages(func(skip, count int) ([]Transfer, error) {
+			return c.ListAccountTransfers(ctx, accountID, append(filters, WithTransferCount(count), WithTransferSkip(skip))...)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range transfers {
+			if seen[t.TransferID] {
+				continue
+			}
+			if t.Status == TransferStatus_Failed && len(t.Disputes) == 0 && (t.FailureReason == nil || !t.FailureReason.IsRetriable()) {
+				continue
+			}
+
+			seen[t.TransferID] = true
+			actionable = append(actionable, t)
+		}
+	}
+
+	return actionable, nil
+}
+
+// FindTransfersByMetadata returns the account's transfers whose metadata has the key set to value, such as the order ID
+// a transfer was created for. Moov can't filter transfers by metadata, so every transfer matching the filters is paged
+// through and checked locally, one call per 200 transfers. Narrow the search with filters such as
+// WithTransferStartDate when the account has many transfers.
+func (c Client) FindTransfersByMetadata(ctx context.Context, accountID, key, value string, filters ...ListTransferFilter) ([]Transfer, error) {
+	return listAllPages(func(skip, count int) ([]Transfer, error) {
+		return c.ListTransfers(ctx, accountID, append(slices.Clip(filters), WithTransferCount(count), WithTransferSkip(skip))...)
+	}, func(t Transfer) bool {
+		v, ok := t.Metadata[key]
+		return ok && v == value
+	})
+}
+
 // GetTransfer retrieves a transfer
 // https://docs.moov.io/api/index.html#tag/Transfers/operation/getTransfer
 func (c Client) GetTransfer(ctx context.Context, accountID, transferID string) (*Transfer, error) {
@@ -190,9 +313,40 @@ func (c Client) GetTransfer(ctx context.Context, accountID, transferID string) (
 
 type TransferPatcher func(patch *patchTransfer)
 
+// PatchTransferMetadata replaces all of the transfer's metadata. A nil map leaves the metadata as it is, use
+// PatchTransferMetadataClear to remove it all.
 func PatchTransferMetadata(metadata map[string]string) TransferPatcher {
 	return func(patch *patchTransfer) {
-		patch.Metadata = metadata
+		if metadata != nil {
+			patch.Metadata = &metadata
+		}
+	}
+}
+
+// PatchTransferMetadataClear removes all of the transfer's metadata
+func PatchTransferMetadataClear() TransferPatcher {
+	return func(patch *patchTransfer) {
+		patch.Metadata = &map[string]string{}
+	}
+}
+
+// PatchTransferMetadataMerge adds the keys to the transfer's metadata, overwriting any that are already set. Moov can
+// only replace metadata as a whole, so the transfer is fetched first to merge with its current metadata. Changes made
+// to the metadata between the two calls are lost.
+func PatchTransferMetadataMerge(metadata map[string]string) TransferPatcher {
+	return func(patch *patchTransfer) {
+		if patch.mergeMetadata == nil {
+			patch.mergeMetadata = map[string]string{}
+		}
+		maps.Copy(patch.mergeMetadata, metadata)
+	}
+}
+
+// PatchTransferMetadataDelete removes the keys from the transfer's metadata. Like PatchTransferMetadataMerge the
+// transfer is fetched first since Moov can only replace metadata as a whole.
+func PatchTransferMetadataDelete(keys ...string) TransferPatcher {
+	return func(patch *patchTransfer) {
+		patch.deleteMetadata = append(patch.deleteMetadata, keys...)
 	}
 }
 
@@ -204,6 +358,25 @@ func (c Client) PatchTransfer(ctx context.Context, accountID, transferID string,
 		p(patch)
 	}
 
+	if patch.mergeMetadata != nil || patch.deleteMetadata != nil {
+		metadata := map[string]string{}
+		if patch.Metadata != nil {
+			maps.Copy(metadata, *patch.Metadata)
+		} else {
+			transfer, err := c.GetTransfer(ctx, accountID, transferID)
+			if err != nil {
+				return nil, err
+			}
+			maps.Copy(metadata, transfer.Metadata)
+		}
+
+		maps.Copy(metadata, patch.mergeMetadata)
+		for _, key := range patch.deleteMetadata {
+			delete(metadata, key)
+		}
+		patch.Metadata = &metadata
+	}
+
 	resp, err := c.CallHttp(ctx,
 		Endpoint(http.MethodPatch, pathTransfer, accountID, transferID),
 		AcceptJson(),
@@ -268,6 +441,73 @@ func (c Client) ListRefunds(ctx context.Context, accountID, transferID string) (
 	return CompletedListOrError[Refund](resp)
 }
 
+type RefundFilter func(f *refundFilter)
+type refundFilter struct {
+	start    time.Time
+	end      time.Time
+	statuses []RefundStatus
+}
+
+// WithRefundStartDate only includes refunds created on or after start
+func WithRefundStartDate(start time.Time) RefundFilter {
+	return func(f *refundFilter) {
+		f.start = start
+	}
+}
+
+// WithRefundEndDate only includes refunds created before end
+func WithRefundEndDate(end time.Time) RefundFilter {
+	return func(f *refundFilter) {
+		f.end = end
+	}
+}
+
+// WithRefundStatus only includes refunds in one of the given statuses
+func WithRefundStatus(statuses ...RefundStatus) RefundFilter {
+	return func(f *refundFilter) {
+		f.statuses = append(f.statuses, statuses...)
+	}
+}
+
+func (f refundFilter) matches(r Refund) bool {
+	if !f.start.IsZero() && r.CreatedOn.Before(f.start) {
+		return false
+	}
+	if !f.end.IsZero() && !r.CreatedOn.Before(f.end) {
+		return false
+	}
+	return len(f.statuses) == 0 || slices.Contains(f.statuses, r.Status)
+}
+
+// ListAccountRefunds lists the refunds of every transfer on the account. Moov only lists refunds per transfer, so this
+// pages through the account's refunded transfers and collects the refunds listed on each.
+func (c Client) ListAccountRefunds(ctx context.Context, accountID string, filters ...RefundFilter) ([]Refund, error) {
+	filter := refundFilter{}
+	for _, f := range filters {
+		f(&filter)
+	}
+
+	transferFilters := []ListTransferFilter{WithTransferRefunded()}
+	if !filter.end.IsZero() {
+		// A refund is always created after its transfer
+		transferFilters = append(transferFilters, WithTransferEndDate(filter.end))
+	}
+
+	var refunds []Refund
+	err := forEachPage(func(skip, count int) ([]Transfer, error) {
+		return c.ListAccountTransfers(ctx, accountID, append(slices.Clip(transferFilters), WithTransferCount(count), WithTransferSkip(skip))...)
+	}, func(transfers []Transfer) error {
+		for _, t := range transfers {
+			refunds = append(refunds, filterList(t.Refunds, []func(Refund) bool{filter.matches})...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return refunds, nil
+}
+
 // GetRefund retrieves a refund for a transfer
 // https://docs.moov.io/api/index.html#tag/Transfers/operation/getRefund
 func (c Client) GetRefund(ctx context.Context, accountID, transferID, refundID string) (*Refund, error) {
@@ -282,6 +522,58 @@ func (c Client) GetRefund(ctx context.Context, accountID, transferID, refundID s
 	return CompletedObjectOrError[Refund](resp)
 }
 
+// RefundAvailable reports the largest refund the transfer can take without attempting one. Refunds that are created or
+// pending count against the transfer's amount along with completed ones.
+func (c Client) RefundAvailable(ctx context.Context, accountID, transferID string) (*RefundAvailability, error) {
+	transfer, err := c.GetTransfer(ctx, accountID, transferID)
+	if err != nil {
+		return nil, err
+	}
+
+	refunds, err := c.ListRefunds(ctx, accountID, transferID)
+	if err != nil {
+		return nil, err
+	}
+
+	availability := &RefundAvailability{
+		TransferID:    transferID,
+		Refunded:      Amount{Currency: transfer.Amount.Currency},
+		MaxRefundable: Amount{Currency: transfer.Amount.Currency},
+	}
+
+	for _, refund := range refunds {
+		if refund.Status == RefundStatus_Failed {
+			continue
+		}
+		if !strings.EqualFold(refund.Amount.Currency, transfer.Amount.Currency) {
+			return nil, fmt.Errorf("%w: transfer is in %s but refund %s is in %s", ErrCurrencyMismatch, transfer.Amount.Currency, refund.RefundID, refund.Amount.Currency)
+		}
+		availability.Refunded.Value += refund.Amount.Value
+	}
+
+	availability.MaxRefundable.Value = max(transfer.Amount.Value-availability.Refunded.Value, 0)
+	availability.Refundable = transfer.Status == TransferStatus_Completed && availability.MaxRefundable.Value > 0
+
+	return availability, nil
+}
+
+// WaitForRefundStatus polls the refund every interval until it reaches one of the given statuses, or any terminal
+// status if none are given. The last fetched refund is returned along with the context's error if it ends first.
+func (c Client) WaitForRefundStatus(ctx context.Context, accountID, transferID, refundID string, interval time.Duration, statuses ...RefundStatus) (*Refund, error) {
+	return poll(ctx, interval, func(ctx context.Context) (*Refund, bool, error) {
+		refund, err := c.GetRefund(ctx, accountID, transferID, refundID)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if len(statuses) == 0 {
+			return refund, refund.Status.IsTerminal(), nil
+		}
+
+		return refund, slices.Contains(statuses, refund.Status), nil
+	})
+}
+
 type CreateReversalArgs callArg
 
 // Can be specified to overwrite a randomly generated one.
@@ -289,9 +581,35 @@ func WithReversalsIdempotencyKey(key uuid.UUID) CreateReversalArgs {
 	return IdempotencyKey(key.String())
 }
 
-// ReverseTransfer reverses a transfer
+// checkReversalAmount marks a reversal to have its amount checked against the transfer before it's sent.
+type checkReversalAmount struct{}
+
+func (checkReversalAmount) apply(call *callBuilder) error {
+	return nil
+}
+
+// WithReversalAmountCheck gets the transfer before reversing it and returns ErrReversalExceedsTransfer if the reversal
+// is for more than what's left of the transfer after earlier refunds.
+func WithReversalAmountCheck() CreateReversalArgs {
+	return checkReversalAmount{}
+}
+
+// ReverseTransfer reverses a transfer, or part of it when an amount is given.
 // https://docs.moov.io/api/index.html#tag/Transfers/operation/reverseTransfer
 func (c Client) ReverseTransfer(ctx context.Context, partnerAccountID, transferID string, refund CreateReversal, options ...CreateReversalArgs) (*CreatedReversal, error) {
+	if refund.Amount < 0 {
+		return nil, fmt.Errorf("%w: reversal amount %d must not be negative", ErrInvalidAmount, refund.Amount)
+	}
+
+	for _, opt := range options {
+		if _, ok := opt.(checkReversalAmount); ok && refund.Amount > 0 {
+			if err := c.checkReversalAmount(ctx, partnerAccountID, transferID, refund.Amount); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
 	args := prependArgs(options,
 		AcceptJson(),
 		WithReversalsIdempotencyKey(uuid.New()),
@@ -306,6 +624,64 @@ func (c Client) ReverseTransfer(ctx context.Context, partnerAccountID, transferI
 	return CompletedObjectOrError[CreatedReversal](resp)
 }
 
+func (c Client) checkReversalAmount(ctx context.Context, accountID, transferID string, amount int64) error {
+	transfer, err := c.GetTransfer(ctx, accountID, transferID)
+	if err != nil {
+		return err
+	}
+
+	remaining := transfer.Amount.Value
+	if transfer.RefundedAmount != nil {
+		remaining -= transfer.RefundedAmount.Value
+	}
+
+	if amount > remaining {
+		return fmt.Errorf("%w: reversing %d but only %d of the transfer remains", ErrReversalExceedsTransfer, amount, remaining)
+	}
+	return nil
+}
+
+// GetReversal retrieves a reversal by the ID of the refund or cancellation it created, see CreatedReversal.ID. Moov
+// has no endpoint for reversals themselves, so the refund is looked up first and then the cancellation.
+func (c Client) GetReversal(ctx context.Context, accountID, transferID, reversalID string) (*CreatedReversal, error) {
+	refund, err := c.GetRefund(ctx, accountID, transferID, reversalID)
+	if err == nil {
+		return &CreatedReversal{Refund: refund}, nil
+	}
+	if resp := ErrorAsCallResponse(err); resp == nil || resp.Status() != StatusNotFound {
+		return nil, err
+	}
+
+	cancellation, err := c.GetCancellation(ctx, accountID, transferID, reversalID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreatedReversal{Cancellation: &CreatedCancellation{
+		CancellationID: cancellation.CancellationID,
+		Status:         cancellation.Status,
+		CreatedOn:      cancellation.CreatedOn,
+	}}, nil
+}
+
+// WaitForReversal gets the reversal every DefaultPollInterval until it reaches one of the target statuses, or any
+// terminal status if none are given. The last fetched reversal is returned along with the context's error if it ends
+// first.
+func (c Client) WaitForReversal(ctx context.Context, accountID, transferID, reversalID string, target ...ReversalStatus) (*CreatedReversal, error) {
+	return poll(ctx, DefaultPollInterval, func(ctx context.Context) (*CreatedReversal, bool, error) {
+		reversal, err := c.GetReversal(ctx, accountID, transferID, reversalID)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if len(target) == 0 {
+			return reversal, reversal.Status().IsTerminal(), nil
+		}
+
+		return reversal, slices.Contains(target, reversal.Status()), nil
+	})
+}
+
 // CancelTransfer cancels a transfer
 // https://docs.moov.io/api/money-movement/transfers/cancel/
 func (c Client) CancelTransfer(ctx context.Context, accountID string, transferID string) (*Cancellation, error) {
@@ -328,12 +704,19 @@ func (c Client) GetCancellation(ctx context.Context, accountID string, transferI
 	return CompletedObjectOrError[Cancellation](resp)
 }
 
-// TransferOptions lists all transfer options between a source and destination
+// TransferOptions lists all transfer options between a source and destination. Moov doesn't convert between currencies,
+// so ErrCurrencyNotSupported is returned without calling Moov for amounts in a currency it doesn't move money in, see
+// Currency.Supported.
 // https://docs.moov.io/api/#tag/Transfers/operation/createTransferOptions
 func (c Client) TransferOptions(ctx context.Context, payload CreateTransferOptions) (*TransferOptions, error) {
+	if payload.Amount.Currency != "" && !Currency(payload.Amount.Currency).Supported() {
+		return nil, fmt.Errorf("%w: transfer options are same-currency only and %s isn't supported", ErrCurrencyNotSupported, payload.Amount.Currency)
+	}
+
 	resp, err := c.CallHttp(ctx,
 		Endpoint(http.MethodPost, pathTransferOptions),
 		AcceptJson(),
+		readOnly(),
 		JsonBody(payload))
 	if err != nil {
 		return nil, err
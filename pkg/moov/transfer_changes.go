@@ -0,0 +1,115 @@
+package moov
+
+import (
+	"context"
+	"time"
+)
+
+// How long after they're created transfers are watched for changes, long enough for ACH transfers to complete.
+const transferChangesLookback = 7 * 24 * time.Hour
+
+// transferState is what's compared between polls to tell if a transfer changed
+type transferState struct {
+	status      TransferStatus
+	completedOn time.Time
+	refunds     int
+	disputes    int
+}
+
+func transferStateOf(t Transfer) transferState {
+	state := transferState{
+		status:   t.Status,
+		refunds:  len(t.Refunds),
+		disputes: len(t.Disputes),
+	}
+	if t.CompletedOn != nil {
+		state.completedOn = *t.CompletedOn
+	}
+	return state
+}
+
+// PollTransferChanges lists the account's transfers every interval and sends each one that's new or that changed since
+// the last check, such as its status moving on or it being refunded or disputed. Moov can't list transfers by when
+// they were updated, so each poll lists the transfers created within the week before it, starting no earlier than
+// `since`. Changes to transfers more than a week old aren't seen.
+//
+// Errors listing transfers are sent on the error channel and polling continues. The error channel holds one error, and
+// errors are dropped while it's full, so polling doesn't stop when only transfers are read. Both channels are closed
+// once the context ends.
+//
+// This is meant as a simple feed for development and operations. Moov doesn't offer a streaming or long-poll endpoint for
+// events, so webhooks, parsed with the mhooks package, should be used to react to transfers in real time in production.
+func (c Client) PollTransferChanges(ctx context.Context, accountID string, since time.Time, interval time.Duration) (<-chan Transfer, <-chan error) {
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+
+	changes := make(chan Transfer)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(changes)
+		defer close(errs)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		seen := map[string]transferState{}
+		seenCreatedOn := map[string]time.Time{}
+
+		for {
+			polledOn := time.Now()
+
+			err := forEachPage(func(skip, count int) ([]Transfer, error) {
+				return c.ListAccountTransfers(ctx, accountID,
+					WithTransferStartDate(since),
+					WithTransferCount(count),
+					WithTransferSkip(skip))
+			}, func(transfers []Transfer) error {
+				for _, t := range transfers {
+					state := transferStateOf(t)
+					if prev, ok := seen[t.TransferID]; ok && prev == state {
+						continue
+					}
+					seen[t.TransferID] = state
+					seenCreatedOn[t.TransferID] = t.CreatedOn
+
+					select {
+					case changes <- t:
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				}
+				return nil
+			})
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				select {
+				case errs <- err:
+				default:
+				}
+			} else {
+				// Move the window along so it only covers transfers that could still change, and forget those left behind
+				if start := polledOn.Add(-transferChangesLookback); start.After(since) {
+					since = start
+				}
+				for id, createdOn := range seenCreatedOn {
+					if createdOn.Before(since) {
+						delete(seen, id)
+						delete(seenCreatedOn, id)
+					}
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return changes, errs
+}
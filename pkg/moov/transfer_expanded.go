@@ -0,0 +1,124 @@
+package moov
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Expandable is a resource related to a transfer that GetTransferExpanded can fetch along with it.
+type Expandable string
+
+// List of Expandable
+const (
+	Expandable_Refunds     Expandable = "refunds"
+	Expandable_Disputes    Expandable = "disputes"
+	Expandable_Source      Expandable = "source"
+	Expandable_Destination Expandable = "destination"
+)
+
+// TransferExpanded is a transfer along with the related resources requested from GetTransferExpanded. Resources that
+// weren't requested, or failed to be fetched, are left empty.
+type TransferExpanded struct {
+	Transfer *Transfer
+
+	Refunds  []Refund
+	Disputes []Dispute
+
+	// Payment methods the funds were pulled from and pushed to.
+	SourcePaymentMethod      *PaymentMethod
+	DestinationPaymentMethod *PaymentMethod
+}
+
+const transferExpandedConcurrency = 4
+
+// GetTransferExpanded gets the transfer and then concurrently fetches the related resources in `include`. If some of
+// the related resources can't be fetched the rest are still returned along with the joined errors.
+func (c Client) GetTransferExpanded(ctx context.Context, accountID, transferID string, include ...Expandable) (*TransferExpanded, error) {
+	for _, resource := range include {
+		switch resource {
+		case Expandable_Refunds, Expandable_Disputes, Expandable_Source, Expandable_Destination:
+		default:
+			return nil, fmt.Errorf("unknown expandable resource %q", resource)
+		}
+	}
+
+	transfer, err := c.GetTransfer(ctx, accountID, transferID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each fetch sets its own result, and they're gathered once all have finished
+	var (
+		names   []string
+		fetches []func() error
+
+		refunds             []Refund
+		disputes            []*Dispute
+		source, destination *PaymentMethod
+	)
+
+	fetch := func(name string, fn func() error) {
+		names = append(names, name)
+		fetches = append(fetches, fn)
+	}
+
+	seen := map[Expandable]bool{}
+	for _, resource := range include {
+		if seen[resource] {
+			continue
+		}
+		seen[resource] = true
+
+		switch resource {
+		case Expandable_Refunds:
+			fetch("refunds", func() (err error) {
+				refunds, err = c.ListRefunds(ctx, accountID, transferID)
+				return err
+			})
+
+		case Expandable_Disputes:
+			// Indexed by the transfer's disputes so they keep their order
+			disputes = make([]*Dispute, len(transfer.Disputes))
+			for i, d := range transfer.Disputes {
+				fetch("dispute "+d.DisputeID, func() (err error) {
+					disputes[i], err = c.GetDispute(ctx, accountID, d.DisputeID)
+					return err
+				})
+			}
+
+		case Expandable_Source:
+			fetch("source", func() (err error) {
+				source, err = c.GetPaymentMethod(ctx, transfer.Source.Account.AccountID, transfer.Source.PaymentMethodID)
+				return err
+			})
+
+		case Expandable_Destination:
+			fetch("destination", func() (err error) {
+				destination, err = c.GetPaymentMethod(ctx, transfer.Destination.Account.AccountID, transfer.Destination.PaymentMethodID)
+				return err
+			})
+		}
+	}
+
+	errs := fanOut(len(fetches), transferExpandedConcurrency, func(i int) error {
+		if err := fetches[i](); err != nil {
+			return fmt.Errorf("expanding %s: %w", names[i], err)
+		}
+		return nil
+	})
+
+	expanded := &TransferExpanded{
+		Transfer:                 transfer,
+		Refunds:                  refunds,
+		SourcePaymentMethod:      source,
+		DestinationPaymentMethod: destination,
+	}
+	for _, d := range disputes {
+		if d != nil {
+			expanded.Disputes = append(expanded.Disputes, *d)
+		}
+	}
+
+	return expanded, errors.Join(errs...)
+}
@@ -0,0 +1,93 @@
+package moov
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+var transferExportHeader = []string{"transferID", "createdOn", "status", "amount", "currency", "source", "destination", "moovFee"}
+
+// ExportTransfersCSV writes every transfer matching the filters to w as CSV, one row per transfer after a header row.
+// Amounts and fees are in the currency's minor units, and source and destination are payment method IDs. Transfers are
+// listed a page at a time, oldest first, and each page is flushed to w before the next is requested, so memory stays
+// bounded no matter how many transfers match.
+//
+// Each page starts from the creation time of the last transfer written rather than skipping an offset, so transfers
+// created while exporting can't shift the pages. Unless the filters set an end date, transfers created after the export
+// started are left out. Ordering, skip, and count filters are overridden to page through the results.
+func (c Client) ExportTransfersCSV(ctx context.Context, w io.Writer, accountID string, filters ...ListTransferFilter) error {
+	call, err := newCall(Endpoint(http.MethodGet, pathTransfers, accountID), prependArgs(filters)...)
+	if err != nil {
+		return err
+	}
+
+	filters = append(slices.Clip(filters), WithTransferOrderBy("createdOn", false))
+	if call.params["endDateTime"] == "" {
+		filters = append(filters, WithTransferEndDate(time.Now()))
+	}
+
+	out := csv.NewWriter(w)
+	if err := out.Write(transferExportHeader); err != nil {
+		return err
+	}
+
+	// Dates are filtered to the second, so the transfers written from the cursor's second are listed again and skipped
+	var cursor time.Time
+	atCursor := map[string]bool{}
+
+	for {
+		page := append(slices.Clip(filters), WithTransferCount(listPageSize), WithTransferSkip(len(atCursor)))
+		if !cursor.IsZero() {
+			page = append(page, WithTransferStartDate(cursor))
+		}
+
+		transfers, err := c.ListTransfers(ctx, accountID, page...)
+		if err != nil {
+			return err
+		}
+
+		for _, t := range transfers {
+			if atCursor[t.TransferID] {
+				continue
+			}
+			if err := out.Write(transferExportRow(t)); err != nil {
+				return err
+			}
+
+			created := t.CreatedOn.Truncate(time.Second)
+			if created.After(cursor) {
+				cursor = created
+				atCursor = map[string]bool{}
+			}
+			atCursor[t.TransferID] = true
+		}
+
+		out.Flush()
+		if err := out.Error(); err != nil {
+			return fmt.Errorf("writing transfers export: %w", err)
+		}
+
+		if len(transfers) < listPageSize {
+			return nil
+		}
+	}
+}
+
+func transferExportRow(t Transfer) []string {
+	return []string{
+		t.TransferID,
+		t.CreatedOn.Format(time.RFC3339),
+		string(t.Status),
+		strconv.FormatInt(t.Amount.Value, 10),
+		t.Amount.Currency,
+		t.Source.PaymentMethodID,
+		t.Destination.PaymentMethodID,
+		strconv.FormatInt(transferMoovFee(t).Value, 10),
+	}
+}
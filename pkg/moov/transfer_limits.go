@@ -0,0 +1,52 @@
+package moov
+
+import (
+	"fmt"
+	"strings"
+)
+
+type transferLimits struct {
+	min Amount
+	max Amount
+}
+
+func (l *transferLimits) validate() error {
+	if l.min.Currency == "" || l.max.Currency == "" {
+		return fmt.Errorf("%w: limits must both have a currency", ErrInvalidAmount)
+	}
+
+	if !strings.EqualFold(l.min.Currency, l.max.Currency) {
+		return fmt.Errorf("%w: limits are in %s and %s", ErrCurrencyMismatch, l.min.Currency, l.max.Currency)
+	}
+
+	if l.min.Value < 0 {
+		return fmt.Errorf("%w: minimum transfer amount %d must not be negative", ErrInvalidAmount, l.min.Value)
+	}
+
+	if l.min.Value > l.max.Value {
+		return fmt.Errorf("minimum transfer amount %d is greater than the maximum %d", l.min.Value, l.max.Value)
+	}
+
+	return nil
+}
+
+// check returns an error if the amount falls outside of the limits. Nil limits allow any amount.
+func (l *transferLimits) check(amount Amount) error {
+	if l == nil {
+		return nil
+	}
+
+	if !strings.EqualFold(amount.Currency, l.min.Currency) {
+		return fmt.Errorf("%w: transfer is in %s but limits are in %s", ErrCurrencyMismatch, amount.Currency, l.min.Currency)
+	}
+
+	if amount.Value < l.min.Value {
+		return fmt.Errorf("%w: %d is less than the minimum of %d", ErrAmountOutOfRange, amount.Value, l.min.Value)
+	}
+
+	if amount.Value > l.max.Value {
+		return fmt.Errorf("%w: %d is greater than the maximum of %d", ErrAmountOutOfRange, amount.Value, l.max.Value)
+	}
+
+	return nil
+}
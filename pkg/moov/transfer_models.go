@@ -1,6 +1,13 @@
 package moov
 
-import "time"
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
 
 // CreateTransfer struct for CreateTransfer
 type CreateTransfer struct {
@@ -9,12 +16,64 @@ type CreateTransfer struct {
 	Amount         Amount                        `json:"amount"`
 	SalesTaxAmount *Amount                       `json:"salesTaxAmount,omitempty"`
 	FacilitatorFee CreateTransfer_FacilitatorFee `json:"facilitatorFee,omitempty"`
-	// An optional description of the transfer for your own internal use.
+	// An optional description of the transfer for your own internal use. It's never shown to the counterparty, use the
+	// card DynamicDescriptor or ACH CompanyEntryDescription on the source or destination to set what's on their statement.
 	Description string `json:"description,omitempty"`
 	// Free-form key-value pair list. Useful for storing information that is not captured elsewhere.
 	Metadata map[string]string `json:"metadata,omitempty"`
 }
 
+// Statement descriptor limits for each rail. Longer descriptors are truncated or rejected by the networks.
+const (
+	MaxCardDynamicDescriptorLength      = 22
+	MaxAchCompanyEntryDescriptionLength = 10
+	MaxAchOriginatingCompanyNameLength  = 16
+)
+
+func (t CreateTransfer) validate() error {
+	if d := t.Source.CardDetails; d != nil {
+		if err := checkDescriptor(d.DynamicDescriptor, MaxCardDynamicDescriptorLength); err != nil {
+			return fmt.Errorf("source.cardDetails.dynamicDescriptor: %w", err)
+		}
+		if err := t.validateCardLevels(d); err != nil {
+			return err
+		}
+	}
+
+	if d := t.Source.AchDetails; d != nil {
+		if err := checkDescriptor(d.CompanyEntryDescription, MaxAchCompanyEntryDescriptionLength); err != nil {
+			return fmt.Errorf("source.achDetails.companyEntryDescription: %w", err)
+		}
+		if err := checkDescriptor(d.OriginatingCompanyName, MaxAchOriginatingCompanyNameLength); err != nil {
+			return fmt.Errorf("source.achDetails.originatingCompanyName: %w", err)
+		}
+	}
+
+	if d := t.Destination.CardDetails; d != nil {
+		if err := checkDescriptor(d.DynamicDescriptor, MaxCardDynamicDescriptorLength); err != nil {
+			return fmt.Errorf("destination.cardDetails.dynamicDescriptor: %w", err)
+		}
+	}
+
+	if d := t.Destination.AchDetails; d != nil {
+		if err := checkDescriptor(d.CompanyEntryDescription, MaxAchCompanyEntryDescriptionLength); err != nil {
+			return fmt.Errorf("destination.achDetails.companyEntryDescription: %w", err)
+		}
+		if err := checkDescriptor(d.OriginatingCompanyName, MaxAchOriginatingCompanyNameLength); err != nil {
+			return fmt.Errorf("destination.achDetails.originatingCompanyName: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func checkDescriptor(descriptor string, limit int) error {
+	if n := utf8.RuneCountInString(descriptor); n > limit {
+		return fmt.Errorf("%w: %q is %d characters, the limit is %d", ErrStatementDescriptorTooLong, descriptor, n, limit)
+	}
+	return nil
+}
+
 // CreateTransfer_Source Where funds for a transfer originate. For the source, you must include either a `paymentMethodID` or a `transferID`. A `transferID` is used to create a [transfer group](https://docs.moov.io/guides/money-movement/transfer-groups/), associating the new transfer with a parent transfer.
 type CreateTransfer_Source struct {
 	TransferID      string                            `json:"transferID,omitempty"`
@@ -28,6 +87,82 @@ type CreateTransfer_CardDetailsSource struct {
 	// An optional override of the default card statement descriptor for a transfer.
 	DynamicDescriptor string             `json:"dynamicDescriptor,omitempty"`
 	TransactionSource *TransactionSource `json:"transactionSource,omitempty"`
+	// Optional purchase data for business cards, which can qualify the transfer for lower interchange rates. Level 2
+	// data is also required when sending Level 3.
+	Level2 *CardLevel2 `json:"level2,omitempty"`
+	Level3 *CardLevel3 `json:"level3,omitempty"`
+}
+
+// CardLevel2 is the Level 2 purchase data of a business card payment. The sales tax is the transfer's SalesTaxAmount,
+// which must be set unless the purchase is tax exempt.
+type CardLevel2 struct {
+	// Purchase order or other reference the customer uses for the purchase.
+	CustomerReference string `json:"customerReference"`
+	TaxExempt         bool   `json:"taxExempt,omitempty"`
+	// Postal code the purchase is shipped to, or the merchant's when it isn't shipped.
+	DestinationPostalCode string `json:"destinationPostalCode,omitempty"`
+}
+
+// CardLevel3 is the Level 3 purchase data of a business card payment, itemizing the purchase.
+type CardLevel3 struct {
+	ShipFromPostalCode string  `json:"shipFromPostalCode,omitempty"`
+	ShipToPostalCode   string  `json:"shipToPostalCode,omitempty"`
+	ShipToCountry      string  `json:"shipToCountry,omitempty"`
+	FreightAmount      *Amount `json:"freightAmount,omitempty"`
+	DutyAmount         *Amount `json:"dutyAmount,omitempty"`
+	DiscountAmount     *Amount `json:"discountAmount,omitempty"`
+	// Items purchased, at least one is required.
+	LineItems []CardLineItem `json:"lineItems"`
+}
+
+// CardLineItem is an item of a CardLevel3 purchase
+type CardLineItem struct {
+	Description string `json:"description"`
+	// Merchant's code for the product, such as its SKU.
+	ProductCode string `json:"productCode,omitempty"`
+	// Optional code categorizing the product, such as a UNSPSC commodity code.
+	CommodityCode  string  `json:"commodityCode,omitempty"`
+	Quantity       int64   `json:"quantity"`
+	UnitOfMeasure  string  `json:"unitOfMeasure,omitempty"`
+	UnitPrice      Amount  `json:"unitPrice"`
+	TaxAmount      *Amount `json:"taxAmount,omitempty"`
+	DiscountAmount *Amount `json:"discountAmount,omitempty"`
+	// Total of the line, including tax and less any discount.
+	Total Amount `json:"total"`
+}
+
+// validateCardLevels checks the fields each level requires are set
+func (t CreateTransfer) validateCardLevels(d *CreateTransfer_CardDetailsSource) error {
+	if d.Level3 != nil && d.Level2 == nil {
+		return fmt.Errorf("source.cardDetails.level2: %w: required with level 3 data", ErrIncompleteCardLevelData)
+	}
+
+	if l2 := d.Level2; l2 != nil {
+		if l2.CustomerReference == "" {
+			return fmt.Errorf("source.cardDetails.level2.customerReference: %w", ErrIncompleteCardLevelData)
+		}
+		if !l2.TaxExempt && t.SalesTaxAmount == nil {
+			return fmt.Errorf("salesTaxAmount: %w: required with level 2 data unless tax exempt", ErrIncompleteCardLevelData)
+		}
+	}
+
+	if l3 := d.Level3; l3 != nil {
+		if len(l3.LineItems) == 0 {
+			return fmt.Errorf("source.cardDetails.level3.lineItems: %w: at least one is required", ErrIncompleteCardLevelData)
+		}
+		for i, item := range l3.LineItems {
+			switch {
+			case item.Description == "":
+				return fmt.Errorf("source.cardDetails.level3.lineItems[%d].description: %w", i, ErrIncompleteCardLevelData)
+			case item.Quantity <= 0:
+				return fmt.Errorf("source.cardDetails.level3.lineItems[%d].quantity: %w: must be positive", i, ErrIncompleteCardLevelData)
+			case item.UnitPrice.Currency == "" || item.Total.Currency == "":
+				return fmt.Errorf("source.cardDetails.level3.lineItems[%d]: %w: unit price and total are required", i, ErrIncompleteCardLevelData)
+			}
+		}
+	}
+
+	return nil
 }
 
 // CreateTransfer_AchDetailsSource struct for CreateTransfer_AchDetailsSource
@@ -78,6 +213,26 @@ type TransferStarted struct {
 	// Identifier for the transfer.
 	TransferID string    `json:"transferID,omitempty"`
 	CreatedOn  time.Time `json:"createdOn,omitempty"`
+
+	// Account the transfer was created under, set by CreateTransferBuilder.Started so the transfer can be polled.
+	accountID string
+}
+
+// Poll gets the transfer every DefaultPollInterval until it reaches one of the target statuses or any terminal status.
+// Without targets it waits for a terminal status. Only transfers returned by CreateTransferBuilder.Started can be polled.
+func (t *TransferStarted) Poll(ctx context.Context, c Client, target ...TransferStatus) (*Transfer, error) {
+	if t.accountID == "" {
+		return nil, fmt.Errorf("transfer %s wasn't started by CreateTransfer so its account is unknown", t.TransferID)
+	}
+
+	return poll(ctx, DefaultPollInterval, func(ctx context.Context) (*Transfer, bool, error) {
+		transfer, err := c.GetTransfer(ctx, t.accountID, t.TransferID)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return transfer, transfer.Status.IsTerminal() || slices.Contains(target, transfer.Status), nil
+	})
 }
 
 // Transfer struct for Transfer
@@ -126,6 +281,49 @@ type Transfer struct {
 	SalesTaxAmount *Amount `json:"salesTaxAmount,omitempty"`
 }
 
+// NetToDestination returns the amount the destination receives, the transfer amount less the facilitator fee's total.
+func (t Transfer) NetToDestination() Amount {
+	net := t.Amount
+	if t.FacilitatorFee != nil {
+		net.Value -= t.FacilitatorFee.Total
+	}
+	return net
+}
+
+// TotalFromSource returns the amount pulled from the source. Moov doesn't add surcharges to transfers, so this is the
+// transfer amount, which already includes any sales tax.
+func (t Transfer) TotalFromSource() Amount {
+	return t.Amount
+}
+
+// ScheduledOrigin returns the schedule and occurrence that created the transfer. ok is false for transfers that weren't
+// created by a schedule.
+func (t Transfer) ScheduledOrigin() (scheduleID, occurrenceID string, ok bool) {
+	if t.ScheduleID == nil || *t.ScheduleID == "" {
+		return "", "", false
+	}
+	if t.OccurrenceID != nil {
+		occurrenceID = *t.OccurrenceID
+	}
+	return *t.ScheduleID, occurrenceID, true
+}
+
+// AuthorizedAmount returns the amount the card issuer authorized for a card transfer. ok is false for transfers that
+// aren't from a card or haven't been authorized yet.
+func (t Transfer) AuthorizedAmount() (authorized Amount, ok bool) {
+	if t.Source.CardDetails == nil || t.Source.CardDetails.AuthorizedAmount == nil {
+		return Amount{}, false
+	}
+	return *t.Source.CardDetails.AuthorizedAmount, true
+}
+
+// IsPartiallyAuthorized reports if the card issuer authorized less than the transfer amount. Callers can then decide to
+// accept the smaller amount or cancel the transfer.
+func (t Transfer) IsPartiallyAuthorized() bool {
+	authorized, ok := t.AuthorizedAmount()
+	return ok && authorized.Value < t.Amount.Value
+}
+
 // Amount A representation of money containing an integer value and its currency.
 type Amount struct {
 	// A 3-letter ISO 4217 currency code.
@@ -134,6 +332,25 @@ type Amount struct {
 	Value int64 `json:"value,omitempty" otel:"value"`
 }
 
+// SumAmounts adds up the amount selected from each item. The total is in the items' currency, uppercased, and
+// ErrCurrencyMismatch is returned if they aren't all in the same one. An empty list sums to a zero Amount without a
+// currency.
+func SumAmounts[T any](items []T, sel func(T) Amount) (Amount, error) {
+	var total Amount
+	for i, item := range items {
+		amount := sel(item)
+		currency := strings.ToUpper(amount.Currency)
+
+		if i == 0 {
+			total.Currency = currency
+		} else if currency != total.Currency {
+			return Amount{}, fmt.Errorf("%w: found %s and %s", ErrCurrencyMismatch, total.Currency, currency)
+		}
+		total.Value += amount.Value
+	}
+	return total, nil
+}
+
 // GetFacilitatorFee Fee you charged your customer for the transfer.
 type GetFacilitatorFee struct {
 	// Total facilitator fee in cents.
@@ -290,7 +507,12 @@ type RtpDetails struct {
 }
 
 type patchTransfer struct {
-	Metadata map[string]string `json:"metadata,omitempty"`
+	// A pointer so metadata can be patched to an empty map, clearing it.
+	Metadata *map[string]string `json:"metadata,omitempty"`
+
+	// Set by the patchers that edit the transfer's current metadata rather than replacing it.
+	mergeMetadata  map[string]string
+	deleteMetadata []string
 }
 
 // CreateRefund Specifies a partial amount to refund. This request body is optional, an empty body will issue a refund for the full amount of the original transfer.
@@ -299,6 +521,17 @@ type CreateRefund struct {
 	Amount int64 `json:"amount,omitempty"`
 }
 
+// RefundAvailability describes how much of a transfer can still be refunded.
+type RefundAvailability struct {
+	TransferID string
+	// True when the transfer's status permits a refund and some of its amount hasn't been refunded yet.
+	Refundable bool
+	// Sum of the refunds that haven't failed.
+	Refunded Amount
+	// The largest amount a new refund can be for.
+	MaxRefundable Amount
+}
+
 type RefundStarted struct {
 	Transfer
 	RefundedTransferID string `otel:"refunded_transfer_id,omitempty"` // original transfer id
@@ -306,20 +539,79 @@ type RefundStarted struct {
 
 // CreateReversal struct for CreateReversal
 type CreateReversal struct {
-	// Amount to reverse in cents. If null, the original transfer's full amount will be reversed. Partial amounts will automatically trigger a refund instead of a cancellation.
+	// Amount to reverse in cents. If zero, the original transfer's full amount will be reversed. Partial amounts will automatically trigger a refund instead of a cancellation.
 	Amount int64 `json:"amount,omitempty"`
 }
 
 // CreatedReversal struct for CreatedReversal
 type CreatedReversal struct {
+	// Set when the transfer hadn't been processed yet and was canceled before any funds moved.
 	Cancellation *CreatedCancellation `json:"cancellation,omitempty"`
-	Refund       *Refund              `json:"refund,omitempty"`
+	// Set when the transfer was already processed and its funds are being returned with a refund.
+	Refund *Refund `json:"refund,omitempty"`
+}
+
+// ReversalOutcome describes how Moov reversed a transfer.
+type ReversalOutcome string
+
+// List of ReversalOutcome
+const (
+	ReversalOutcome_Unknown      ReversalOutcome = "unknown"
+	ReversalOutcome_Cancellation ReversalOutcome = "cancellation"
+	ReversalOutcome_Refund       ReversalOutcome = "refund"
+)
+
+// ID returns the ID of the refund or cancellation the reversal created, for GetReversal and WaitForReversal.
+func (r CreatedReversal) ID() string {
+	switch {
+	case r.Cancellation != nil:
+		return r.Cancellation.CancellationID
+	case r.Refund != nil:
+		return r.Refund.RefundID
+	default:
+		return ""
+	}
+}
+
+// Outcome reports if the reversal canceled the transfer before it was processed or refunded it afterwards.
+func (r CreatedReversal) Outcome() ReversalOutcome {
+	switch {
+	case r.Cancellation != nil:
+		return ReversalOutcome_Cancellation
+	case r.Refund != nil:
+		return ReversalOutcome_Refund
+	default:
+		return ReversalOutcome_Unknown
+	}
+}
+
+// Status returns the status of the reversal from whichever of the cancellation or refund was created.
+func (r CreatedReversal) Status() ReversalStatus {
+	switch {
+	case r.Cancellation != nil:
+		switch r.Cancellation.Status {
+		case CancellationStatus_Completed:
+			return ReversalStatus_Completed
+		case CancellationStatus_Failed:
+			return ReversalStatus_Failed
+		}
+	case r.Refund != nil:
+		switch r.Refund.Status {
+		case RefundStatus_Completed:
+			return ReversalStatus_Completed
+		case RefundStatus_Failed:
+			return ReversalStatus_Failed
+		}
+	}
+
+	return ReversalStatus_Pending
 }
 
 // CreatedCancellation struct for CreatedCancellation
 type CreatedCancellation struct {
-	Status    CancellationStatus `json:"status,omitempty"`
-	CreatedOn time.Time          `json:"createdOn,omitempty"`
+	CancellationID string             `json:"cancellationID,omitempty"`
+	Status         CancellationStatus `json:"status,omitempty"`
+	CreatedOn      time.Time          `json:"createdOn,omitempty"`
 }
 
 // CreateTransferOptions struct for CreateTransferOptions
@@ -400,6 +692,12 @@ const (
 	FailureReason_Processing_Error          FailureReason = "processing-error"
 )
 
+// IsRetriable reports if a new transfer between the same payment methods could succeed, such as once the wallet is
+// funded. Payment method errors and risk rejections need the payment method or account fixed first.
+func (r FailureReason) IsRetriable() bool {
+	return r == FailureReason_Wallet_Insufficient_Funds || r == FailureReason_Processing_Error
+}
+
 // RefundStatus the model 'RefundStatus'
 type RefundStatus string
 
@@ -411,6 +709,46 @@ const (
 	RefundStatus_Failed    RefundStatus = "failed"
 )
 
+// IsTerminal reports if the transfer has finished and its status will no longer change.
+func (s TransferStatus) IsTerminal() bool {
+	switch s {
+	case TransferStatus_Completed, TransferStatus_Failed, TransferStatus_Reversed, TransferStatus_Canceled:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsTerminal reports if the refund has finished and its status will no longer change.
+func (s RefundStatus) IsTerminal() bool {
+	return s == RefundStatus_Completed || s == RefundStatus_Failed
+}
+
+// IsSuccess reports if the refund finished successfully.
+func (s RefundStatus) IsSuccess() bool {
+	return s == RefundStatus_Completed
+}
+
+// ReversalStatus Status of a reversal, regardless of if it was handled as a cancellation or a refund.
+type ReversalStatus string
+
+// List of ReversalStatus
+const (
+	ReversalStatus_Pending   ReversalStatus = "pending"
+	ReversalStatus_Completed ReversalStatus = "completed"
+	ReversalStatus_Failed    ReversalStatus = "failed"
+)
+
+// IsTerminal reports if the reversal has finished and its status will no longer change.
+func (s ReversalStatus) IsTerminal() bool {
+	return s == ReversalStatus_Completed || s == ReversalStatus_Failed
+}
+
+// IsSuccess reports if the reversal finished successfully.
+func (s ReversalStatus) IsSuccess() bool {
+	return s == ReversalStatus_Completed
+}
+
 // CardFailureCode the model 'CardFailureCode'
 type CardFailureCode string
 
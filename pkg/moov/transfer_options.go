@@ -0,0 +1,182 @@
+package moov
+
+import (
+	"context"
+	"errors"
+	"slices"
+)
+
+// SourcesFor returns the source options that move money over the given rail
+func (o TransferOptions) SourcesFor(rail Rail) []PaymentMethod {
+	return paymentMethodsFor(o.SourceOptions, rail)
+}
+
+// DestinationsFor returns the destination options that move money over the given rail
+func (o TransferOptions) DestinationsFor(rail Rail) []PaymentMethod {
+	return paymentMethodsFor(o.DestinationOptions, rail)
+}
+
+func paymentMethodsFor(options []PaymentMethod, rail Rail) []PaymentMethod {
+	var found []PaymentMethod
+	for _, pm := range options {
+		if pm.PaymentMethodType.Rail() == rail {
+			found = append(found, pm)
+		}
+	}
+	return found
+}
+
+// TransferCost returns what a transfer from source to destination costs, in whatever unit the caller ranks by such as
+// the fee in cents under their account's fee plan, or false if the pair shouldn't be used.
+type TransferCost func(source, destination PaymentMethod) (int64, bool)
+
+// Cheapest picks the source and destination pair that costs the least. Transfer options don't include fees, which depend
+// on the account's fee plan, so each pair is costed by the caller. Ties go to the pair listed first. Returns false if
+// cost rejects every pair.
+func (o TransferOptions) Cheapest(cost TransferCost) (PaymentMethod, PaymentMethod, bool) {
+	var source, destination PaymentMethod
+	var best int64
+	found := false
+
+	for _, s := range o.SourceOptions {
+		for _, d := range o.DestinationOptions {
+			c, ok := cost(s, d)
+			if ok && (!found || c < best) {
+				source, destination, best, found = s, d, c, true
+			}
+		}
+	}
+
+	return source, destination, found
+}
+
+// TransferOption is a source or destination option annotated with if the accounts have the capabilities it needs.
+type TransferOption struct {
+	PaymentMethod
+	// If every capability the option needs is enabled
+	Available bool
+	// Requirements still due on the capabilities the option needs, or a capability's name when it isn't requested, is
+	// disabled, or has nothing listed as due.
+	BlockingRequirements []string
+}
+
+// CapabilityTransferOptions are the options of TransferOptionsWithCapabilities.
+type CapabilityTransferOptions struct {
+	SourceOptions      []TransferOption
+	DestinationOptions []TransferOption
+}
+
+// capabilitiesNeeded lists the capabilities the source and destination accounts need for an option. Every transfer
+// needs transfers on both accounts. Wallets need wallet, paying out needs send-funds on the source, and collecting
+// from a customer needs collect-funds on the destination.
+func capabilitiesNeeded(pmType PaymentMethodType, isSource bool) (source, destination []CapabilityName) {
+	source = []CapabilityName{CapabilityName_Transfers}
+	destination = []CapabilityName{CapabilityName_Transfers}
+
+	switch pmType {
+	case PaymentMethodType_MoovWallet:
+		if isSource {
+			source = append(source, CapabilityName_Wallet)
+		} else {
+			destination = append(destination, CapabilityName_Wallet)
+		}
+	case PaymentMethodType_AchDebitFund, PaymentMethodType_AchCreditStandard, PaymentMethodType_AchCreditSameDay,
+		PaymentMethodType_RtpCredit, PaymentMethodType_PushToCard:
+		source = append(source, CapabilityName_SendFunds)
+	case PaymentMethodType_AchDebitCollect, PaymentMethodType_CardPayment, PaymentMethodType_ApplePay,
+		PaymentMethodType_PullFromCard:
+		destination = append(destination, CapabilityName_CollectFunds)
+	}
+
+	return source, destination
+}
+
+const transferOptionsConcurrency = 2
+
+// TransferOptionsWithCapabilities lists the transfer options like TransferOptions and marks each with whether the
+// source and destination accounts have the capabilities it needs enabled, so rails an account can't use yet aren't
+// offered. The capabilities of each account given in the payload are listed concurrently. Options are marked available
+// when the payload doesn't give the account they depend on, as there's nothing to check.
+func (c Client) TransferOptionsWithCapabilities(ctx context.Context, payload CreateTransferOptions) (*CapabilityTransferOptions, error) {
+	options, err := c.TransferOptions(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var accountIDs []string
+	for _, id := range []string{payload.Source.AccountID, payload.Destination.AccountID} {
+		if id != "" && !slices.Contains(accountIDs, id) {
+			accountIDs = append(accountIDs, id)
+		}
+	}
+
+	results := make([][]Capability, len(accountIDs))
+	errs := fanOut(len(accountIDs), transferOptionsConcurrency, func(i int) error {
+		var err error
+		results[i], err = c.ListCapabilities(ctx, accountIDs[i])
+		return err
+	})
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	capabilities := make(map[string][]Capability, len(accountIDs))
+	for i, accountID := range accountIDs {
+		capabilities[accountID] = results[i]
+	}
+
+	annotate := func(pms []PaymentMethod, isSource bool) []TransferOption {
+		annotated := make([]TransferOption, len(pms))
+		for i, pm := range pms {
+			source, destination := capabilitiesNeeded(pm.PaymentMethodType, isSource)
+
+			var blocking []string
+			blocking = append(blocking, blockingRequirements(payload.Source.AccountID, capabilities, source)...)
+			blocking = append(blocking, blockingRequirements(payload.Destination.AccountID, capabilities, destination)...)
+
+			annotated[i] = TransferOption{
+				PaymentMethod:        pm,
+				Available:            len(blocking) == 0,
+				BlockingRequirements: blocking,
+			}
+		}
+		return annotated
+	}
+
+	return &CapabilityTransferOptions{
+		SourceOptions:      annotate(options.SourceOptions, true),
+		DestinationOptions: annotate(options.DestinationOptions, false),
+	}, nil
+}
+
+// blockingRequirements lists what's keeping the needed capabilities of the account from being enabled.
+func blockingRequirements(accountID string, capabilities map[string][]Capability, needed []CapabilityName) []string {
+	if accountID == "" {
+		return nil
+	}
+
+	var blocking []string
+	for _, name := range needed {
+		i := slices.IndexFunc(capabilities[accountID], func(c Capability) bool { return c.Capability == name })
+		if i < 0 {
+			blocking = append(blocking, string(name))
+			continue
+		}
+
+		capability := capabilities[accountID][i]
+		if capability.Status == CapabilityStatus_Enabled {
+			continue
+		}
+
+		outstanding := capability.OutstandingRequirements()
+		if len(outstanding) == 0 {
+			blocking = append(blocking, string(name))
+		}
+		for _, id := range outstanding {
+			if !slices.Contains(blocking, string(id)) {
+				blocking = append(blocking, string(id))
+			}
+		}
+	}
+	return blocking
+}
@@ -1,8 +1,20 @@
 package moov_test
 
 import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 
 	"github.com/moovfinancial/moov-go/pkg/moov"
@@ -138,3 +150,1371 @@ func Test_Cancellations(t *testing.T) {
 		require.Equal(t, createdCancellation.CancellationID, fetchedCancellation.CancellationID)
 	})
 }
+
+func Test_RefundAndReversalStatuses(t *testing.T) {
+	refunds := []struct {
+		status   moov.RefundStatus
+		terminal bool
+		success  bool
+	}{
+		{moov.RefundStatus_Created, false, false},
+		{moov.RefundStatus_Pending, false, false},
+		{moov.RefundStatus_Completed, true, true},
+		{moov.RefundStatus_Failed, true, false},
+	}
+	for _, r := range refunds {
+		require.Equal(t, r.terminal, r.status.IsTerminal(), r.status)
+		require.Equal(t, r.success, r.status.IsSuccess(), r.status)
+	}
+
+	reversals := []struct {
+		reversal moov.CreatedReversal
+		status   moov.ReversalStatus
+	}{
+		{moov.CreatedReversal{}, moov.ReversalStatus_Pending},
+		{moov.CreatedReversal{Cancellation: &moov.CreatedCancellation{Status: moov.CancellationStatus_Pending}}, moov.ReversalStatus_Pending},
+		{moov.CreatedReversal{Cancellation: &moov.CreatedCancellation{Status: moov.CancellationStatus_Completed}}, moov.ReversalStatus_Completed},
+		{moov.CreatedReversal{Cancellation: &moov.CreatedCancellation{Status: moov.CancellationStatus_Failed}}, moov.ReversalStatus_Failed},
+		{moov.CreatedReversal{Refund: &moov.Refund{Status: moov.RefundStatus_Created}}, moov.ReversalStatus_Pending},
+		{moov.CreatedReversal{Refund: &moov.Refund{Status: moov.RefundStatus_Completed}}, moov.ReversalStatus_Completed},
+		{moov.CreatedReversal{Refund: &moov.Refund{Status: moov.RefundStatus_Failed}}, moov.ReversalStatus_Failed},
+	}
+	for _, r := range reversals {
+		require.Equal(t, r.status, r.reversal.Status())
+		require.Equal(t, r.status.IsTerminal(), r.status != moov.ReversalStatus_Pending)
+	}
+}
+
+func Test_WaitForRefundStatus(t *testing.T) {
+	statuses := []moov.RefundStatus{moov.RefundStatus_Created, moov.RefundStatus_Pending, moov.RefundStatus_Completed}
+
+	calls := 0
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/accounts/account-id/transfers/transfer-id/refunds/refund-id", r.URL.Path)
+
+		status := statuses[min(calls, len(statuses)-1)]
+		calls++
+		writeJson(t, w, http.StatusOK, moov.Refund{RefundID: "refund-id", Status: status})
+	}))
+
+	t.Run("until terminal", func(t *testing.T) {
+		calls = 0
+		refund, err := mc.WaitForRefundStatus(BgCtx(), "account-id", "transfer-id", "refund-id", time.Millisecond)
+		require.NoError(t, err)
+		require.Equal(t, moov.RefundStatus_Completed, refund.Status)
+		require.Equal(t, 3, calls)
+	})
+
+	t.Run("until specific status", func(t *testing.T) {
+		calls = 0
+		refund, err := mc.WaitForRefundStatus(BgCtx(), "account-id", "transfer-id", "refund-id", time.Millisecond, moov.RefundStatus_Pending)
+		require.NoError(t, err)
+		require.Equal(t, moov.RefundStatus_Pending, refund.Status)
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("context ends", func(t *testing.T) {
+		calls = 0
+		ctx, cancel := context.WithTimeout(BgCtx(), 20*time.Millisecond)
+		defer cancel()
+
+		refund, err := mc.WaitForRefundStatus(ctx, "account-id", "transfer-id", "refund-id", time.Hour)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Equal(t, moov.RefundStatus_Created, refund.Status)
+	})
+}
+
+func Test_WaitForReversal(t *testing.T) {
+	gets := 0
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/accounts/account-id/transfers/transfer-id/refunds/refund-id":
+			status := moov.RefundStatus_Pending
+			if gets > 0 {
+				status = moov.RefundStatus_Completed
+			}
+			gets++
+			writeJson(t, w, http.StatusOK, moov.Refund{RefundID: "refund-id", Status: status})
+		case "/accounts/account-id/transfers/transfer-id/refunds/cancellation-id":
+			writeJson(t, w, http.StatusNotFound, map[string]string{"error": "refund not found"})
+		case "/accounts/account-id/transfers/transfer-id/cancellations/cancellation-id":
+			writeJson(t, w, http.StatusOK, moov.Cancellation{CancellationID: "cancellation-id", Status: moov.CancellationStatus_Completed})
+		default:
+			t.Errorf("unexpected request to %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	t.Run("pending to completed", func(t *testing.T) {
+		reversal, err := mc.WaitForReversal(BgCtx(), "account-id", "transfer-id", "refund-id")
+		require.NoError(t, err)
+		require.Equal(t, moov.ReversalOutcome_Refund, reversal.Outcome())
+		require.Equal(t, moov.ReversalStatus_Completed, reversal.Status())
+		require.Equal(t, "refund-id", reversal.ID())
+		require.Equal(t, 2, gets)
+	})
+
+	t.Run("cancellation", func(t *testing.T) {
+		reversal, err := mc.WaitForReversal(BgCtx(), "account-id", "transfer-id", "cancellation-id")
+		require.NoError(t, err)
+		require.Equal(t, moov.ReversalOutcome_Cancellation, reversal.Outcome())
+		require.Equal(t, moov.ReversalStatus_Completed, reversal.Status())
+		require.Equal(t, "cancellation-id", reversal.ID())
+	})
+}
+
+func Test_TransferStarted_Poll(t *testing.T) {
+	gets := 0
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/accounts/account-id/transfers":
+			writeJson(t, w, http.StatusOK, moov.TransferStarted{TransferID: "transfer-id"})
+		case r.Method == http.MethodGet && r.URL.Path == "/accounts/account-id/transfers/transfer-id":
+			status := moov.TransferStatus_Pending
+			if gets > 0 {
+				status = moov.TransferStatus_Completed
+			}
+			gets++
+			writeJson(t, w, http.StatusOK, moov.Transfer{TransferID: "transfer-id", Status: status})
+		default:
+			t.Errorf("unexpected request to %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	started, err := mc.CreateTransfer(BgCtx(), "account-id", moov.CreateTransfer{
+		Amount: moov.Amount{Currency: "USD", Value: 100},
+	}).Started()
+	require.NoError(t, err)
+
+	transfer, err := started.Poll(BgCtx(), *mc, moov.TransferStatus_Completed)
+	require.NoError(t, err)
+	require.Equal(t, moov.TransferStatus_Completed, transfer.Status)
+	require.Equal(t, 2, gets)
+
+	t.Run("not from CreateTransfer", func(t *testing.T) {
+		_, err := (&moov.TransferStarted{TransferID: "transfer-id"}).Poll(BgCtx(), *mc)
+		require.Error(t, err)
+	})
+}
+
+func Test_TransferLimits(t *testing.T) {
+	calls := 0
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		writeJson(t, w, http.StatusOK, moov.TransferStarted{TransferID: "transfer-id"})
+	}), moov.WithTransferLimits(
+		moov.Amount{Currency: "USD", Value: 100},
+		moov.Amount{Currency: "USD", Value: 10_000},
+	))
+
+	create := func(amount moov.Amount) error {
+		_, err := mc.CreateTransfer(BgCtx(), "account-id", moov.CreateTransfer{Amount: amount}).Started()
+		return err
+	}
+
+	require.NoError(t, create(moov.Amount{Currency: "USD", Value: 100}))
+	require.NoError(t, create(moov.Amount{Currency: "usd", Value: 10_000}))
+	require.Equal(t, 2, calls)
+
+	require.ErrorIs(t, create(moov.Amount{Currency: "USD", Value: 99}), moov.ErrAmountOutOfRange)
+	require.ErrorIs(t, create(moov.Amount{Currency: "USD", Value: 10_001}), moov.ErrAmountOutOfRange)
+	require.ErrorIs(t, create(moov.Amount{Currency: "CAD", Value: 500}), moov.ErrCurrencyMismatch)
+
+	_, _, err := mc.CreateTransfer(BgCtx(), "account-id", moov.CreateTransfer{
+		Amount: moov.Amount{Currency: "USD", Value: 1},
+	}).WaitForRailResponse()
+	require.ErrorIs(t, err, moov.ErrAmountOutOfRange)
+
+	// Nothing outside of the limits made it to the API
+	require.Equal(t, 2, calls)
+
+	t.Run("invalid limits", func(t *testing.T) {
+		_, err := moov.NewClient(moov.WithTransferLimits(
+			moov.Amount{Currency: "USD", Value: 100},
+			moov.Amount{Currency: "CAD", Value: 200},
+		))
+		require.ErrorIs(t, err, moov.ErrCurrencyMismatch)
+
+		_, err = moov.NewClient(moov.WithTransferLimits(
+			moov.Amount{Currency: "USD", Value: 200},
+			moov.Amount{Currency: "USD", Value: 100},
+		))
+		require.Error(t, err)
+
+		// A zero max isn't unlimited
+		_, err = moov.NewClient(moov.WithTransferLimits(
+			moov.Amount{Currency: "USD", Value: 100},
+			moov.Amount{Currency: "USD"},
+		))
+		require.Error(t, err)
+
+		_, err = moov.NewClient(moov.WithTransferLimits(
+			moov.Amount{Value: 100},
+			moov.Amount{Value: 200},
+		))
+		require.ErrorIs(t, err, moov.ErrInvalidAmount)
+	})
+
+	t.Run("transfers without a currency", func(t *testing.T) {
+		require.ErrorIs(t, create(moov.Amount{Value: 500}), moov.ErrCurrencyMismatch)
+	})
+}
+
+func Test_CreateTransfer_StatementDescriptors(t *testing.T) {
+	calls := 0
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		writeJson(t, w, http.StatusOK, moov.TransferStarted{TransferID: "transfer-id"})
+	}))
+
+	create := func(transfer moov.CreateTransfer) error {
+		transfer.Amount = moov.Amount{Currency: "USD", Value: 100}
+		_, err := mc.CreateTransfer(BgCtx(), "account-id", transfer).Started()
+		return err
+	}
+
+	t.Run("ach", func(t *testing.T) {
+		err := create(moov.CreateTransfer{
+			Description: "an internal description that's longer than any descriptor",
+			Source: moov.CreateTransfer_Source{
+				AchDetails: &moov.CreateTransfer_AchDetailsSource{CompanyEntryDescription: "PAYROLL"},
+			},
+		})
+		require.NoError(t, err)
+
+		err = create(moov.CreateTransfer{
+			Source: moov.CreateTransfer_Source{
+				AchDetails: &moov.CreateTransfer_AchDetailsSource{CompanyEntryDescription: "PAYROLL MAY"},
+			},
+		})
+		require.ErrorIs(t, err, moov.ErrStatementDescriptorTooLong)
+		require.ErrorContains(t, err, "source.achDetails.companyEntryDescription")
+	})
+
+	t.Run("card", func(t *testing.T) {
+		err := create(moov.CreateTransfer{
+			Destination: moov.CreateTransfer_Destination{
+				CardDetails: &moov.CreateTransfer_CardDetailsDestination{DynamicDescriptor: "WHOLE BODY FITNESS MAY"},
+			},
+		})
+		require.NoError(t, err)
+
+		err = create(moov.CreateTransfer{
+			Destination: moov.CreateTransfer_Destination{
+				CardDetails: &moov.CreateTransfer_CardDetailsDestination{DynamicDescriptor: "WHOLE BODY FITNESS MAY 1"},
+			},
+		})
+		require.ErrorIs(t, err, moov.ErrStatementDescriptorTooLong)
+		require.ErrorContains(t, err, "destination.cardDetails.dynamicDescriptor")
+	})
+
+	// Descriptors that are too long never made it to the API
+	require.Equal(t, 2, calls)
+}
+
+func Test_ListAccountTransfers(t *testing.T) {
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/accounts/account-id/transfers", r.URL.Path)
+		require.Equal(t, "account-id", r.URL.Query().Get("accountIDs"))
+		require.Equal(t, "completed", r.URL.Query().Get("status"))
+
+		writeJson(t, w, http.StatusOK, []moov.Transfer{{TransferID: "transfer-id"}})
+	}))
+
+	transfers, err := mc.ListAccountTransfers(BgCtx(), "account-id", moov.WithTransferStatus("completed"))
+	require.NoError(t, err)
+	require.Len(t, transfers, 1)
+}
+
+func Test_ListAccountRefunds(t *testing.T) {
+	day := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/accounts/account-id/transfers" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.Equal(t, "true", r.URL.Query().Get("refunded"))
+		require.Equal(t, "account-id", r.URL.Query().Get("accountIDs"))
+
+		// Refunds come listed on their transfers, so they aren't fetched again
+		writeJson(t, w, http.StatusOK, []moov.Transfer{
+			{TransferID: "transfer-1", Refunds: []moov.Refund{
+				{RefundID: "refund-1a", CreatedOn: day.Add(time.Hour), Status: moov.RefundStatus_Completed},
+				{RefundID: "refund-1b", CreatedOn: day.Add(-time.Hour), Status: moov.RefundStatus_Completed},
+			}},
+			{TransferID: "transfer-2", Refunds: []moov.Refund{
+				{RefundID: "refund-2a", CreatedOn: day.Add(2 * time.Hour), Status: moov.RefundStatus_Failed},
+			}},
+			{TransferID: "transfer-3", Refunds: []moov.Refund{
+				{RefundID: "refund-3a", CreatedOn: day.Add(3 * time.Hour), Status: moov.RefundStatus_Completed},
+			}},
+		})
+	}))
+
+	t.Run("all", func(t *testing.T) {
+		refunds, err := mc.ListAccountRefunds(BgCtx(), "account-id")
+		require.NoError(t, err)
+
+		ids := []string{}
+		for _, r := range refunds {
+			ids = append(ids, r.RefundID)
+		}
+		require.Equal(t, []string{"refund-1a", "refund-1b", "refund-2a", "refund-3a"}, ids)
+	})
+
+	t.Run("filtered", func(t *testing.T) {
+		refunds, err := mc.ListAccountRefunds(BgCtx(), "account-id",
+			moov.WithRefundStartDate(day),
+			moov.WithRefundEndDate(day.AddDate(0, 0, 1)),
+			moov.WithRefundStatus(moov.RefundStatus_Completed))
+		require.NoError(t, err)
+
+		ids := []string{}
+		for _, r := range refunds {
+			ids = append(ids, r.RefundID)
+		}
+		require.Equal(t, []string{"refund-1a", "refund-3a"}, ids)
+	})
+}
+
+func Test_Transfer_ScheduledOrigin(t *testing.T) {
+	input := []byte(`{
+		"transferID": "ec7e1848-dc80-4ab0-8827-dd7fc0737b43",
+		"createdOn": "2024-05-01T00:00:00Z",
+		"status": "completed",
+		"amount": {"currency": "USD", "value": 1204},
+		"scheduleID": "9506dbf6-4208-44c3-ad8a-e4431660e1f2",
+		"occurrenceID": "0f1d2c3b-4a59-4768-8776-655443322110"
+	}`)
+
+	transfer := new(moov.Transfer)
+
+	dec := json.NewDecoder(bytes.NewReader(input))
+	dec.DisallowUnknownFields()
+	require.NoError(t, dec.Decode(transfer))
+
+	scheduleID, occurrenceID, ok := transfer.ScheduledOrigin()
+	require.True(t, ok)
+	require.Equal(t, "9506dbf6-4208-44c3-ad8a-e4431660e1f2", scheduleID)
+	require.Equal(t, "0f1d2c3b-4a59-4768-8776-655443322110", occurrenceID)
+
+	_, _, ok = moov.Transfer{TransferID: "transfer-id"}.ScheduledOrigin()
+	require.False(t, ok)
+}
+
+func Test_Transfer_PartialAuthorization(t *testing.T) {
+	input := []byte(`{
+		"transferID": "ec7e1848-dc80-4ab0-8827-dd7fc0737b43",
+		"createdOn": "2024-05-01T00:00:00Z",
+		"status": "pending",
+		"amount": {"currency": "USD", "value": 10000},
+		"source": {
+			"paymentMethodID": "9506dbf6-4208-44c3-ad8a-e4431660e1f2",
+			"paymentMethodType": "card-payment",
+			"cardDetails": {
+				"status": "confirmed",
+				"authorizedAmount": {"currency": "USD", "value": 6000}
+			}
+		}
+	}`)
+
+	transfer := new(moov.Transfer)
+
+	dec := json.NewDecoder(bytes.NewReader(input))
+	dec.DisallowUnknownFields()
+	require.NoError(t, dec.Decode(transfer))
+
+	authorized, ok := transfer.AuthorizedAmount()
+	require.True(t, ok)
+	require.Equal(t, moov.Amount{Currency: "USD", Value: 6000}, authorized)
+	require.True(t, transfer.IsPartiallyAuthorized())
+
+	// Fully authorized
+	transfer.Source.CardDetails.AuthorizedAmount.Value = 10000
+	require.False(t, transfer.IsPartiallyAuthorized())
+
+	// Not a card transfer
+	_, ok = moov.Transfer{Amount: moov.Amount{Currency: "USD", Value: 10000}}.AuthorizedAmount()
+	require.False(t, ok)
+	require.False(t, moov.Transfer{}.IsPartiallyAuthorized())
+}
+
+func Test_RefundAvailable(t *testing.T) {
+	status := moov.TransferStatus_Completed
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/accounts/account-id/transfers/transfer-id":
+			writeJson(t, w, http.StatusOK, moov.Transfer{
+				TransferID: "transfer-id",
+				Status:     status,
+				Amount:     moov.Amount{Currency: "USD", Value: 10_000},
+			})
+		case "/accounts/account-id/transfers/transfer-id/refunds":
+			writeJson(t, w, http.StatusOK, []moov.Refund{
+				{RefundID: "refund-1", Status: moov.RefundStatus_Completed, Amount: moov.Amount{Currency: "USD", Value: 2_500}},
+				{RefundID: "refund-2", Status: moov.RefundStatus_Pending, Amount: moov.Amount{Currency: "USD", Value: 1_000}},
+				{RefundID: "refund-3", Status: moov.RefundStatus_Failed, Amount: moov.Amount{Currency: "USD", Value: 5_000}},
+			})
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	availability, err := mc.RefundAvailable(BgCtx(), "account-id", "transfer-id")
+	require.NoError(t, err)
+	require.True(t, availability.Refundable)
+	require.Equal(t, moov.Amount{Currency: "USD", Value: 3_500}, availability.Refunded)
+	require.Equal(t, moov.Amount{Currency: "USD", Value: 6_500}, availability.MaxRefundable)
+
+	status = moov.TransferStatus_Failed
+	availability, err = mc.RefundAvailable(BgCtx(), "account-id", "transfer-id")
+	require.NoError(t, err)
+	require.False(t, availability.Refundable)
+}
+
+func Test_CreatedReversal_Outcome(t *testing.T) {
+	cases := []struct {
+		name    string
+		input   string
+		outcome moov.ReversalOutcome
+	}{
+		{
+			name:    "canceled before processing",
+			input:   `{"cancellation": {"status": "completed", "createdOn": "2024-05-01T00:00:00Z"}}`,
+			outcome: moov.ReversalOutcome_Cancellation,
+		},
+		{
+			name:    "refunded after processing",
+			input:   `{"refund": {"refundID": "refund-id", "status": "created", "amount": {"currency": "USD", "value": 1204}, "createdOn": "2024-05-01T00:00:00Z", "updatedOn": "2024-05-01T00:00:00Z"}}`,
+			outcome: moov.ReversalOutcome_Refund,
+		},
+		{
+			name:    "empty",
+			input:   `{}`,
+			outcome: moov.ReversalOutcome_Unknown,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			reversal := new(moov.CreatedReversal)
+
+			dec := json.NewDecoder(bytes.NewReader([]byte(tc.input)))
+			dec.DisallowUnknownFields()
+			require.NoError(t, dec.Decode(reversal))
+
+			require.Equal(t, tc.outcome, reversal.Outcome())
+		})
+	}
+}
+
+func Test_GetTransferExpanded(t *testing.T) {
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/accounts/account-id/transfers/transfer-id":
+			writeJson(t, w, http.StatusOK, moov.Transfer{
+				TransferID: "transfer-id",
+				Disputes:   []moov.GetDispute{{DisputeID: "dispute-1"}, {DisputeID: "dispute-2"}, {DisputeID: "dispute-3"}},
+			})
+		case "/accounts/account-id/transfers/transfer-id/refunds":
+			writeJson(t, w, http.StatusOK, []moov.Refund{{RefundID: "refund-1"}})
+		case "/accounts/account-id/disputes/dispute-1", "/accounts/account-id/disputes/dispute-3":
+			writeJson(t, w, http.StatusOK, moov.Dispute{DisputeID: strings.TrimPrefix(r.URL.Path, "/accounts/account-id/disputes/")})
+		case "/accounts/account-id/disputes/dispute-2":
+			writeJson(t, w, http.StatusNotFound, map[string]string{"error": "not found"})
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	expanded, err := mc.GetTransferExpanded(BgCtx(), "account-id", "transfer-id", moov.Expandable_Refunds, moov.Expandable_Disputes)
+
+	// The dispute that couldn't be fetched is reported while everything else is returned
+	require.Error(t, err)
+	require.Equal(t, moov.StatusNotFound, moov.ErrorAsCallResponse(err).Status())
+	require.ErrorContains(t, err, "dispute-2")
+
+	require.Equal(t, "transfer-id", expanded.Transfer.TransferID)
+	require.Len(t, expanded.Refunds, 1)
+	require.Len(t, expanded.Disputes, 2)
+	require.Equal(t, "dispute-1", expanded.Disputes[0].DisputeID)
+	require.Equal(t, "dispute-3", expanded.Disputes[1].DisputeID)
+	require.Nil(t, expanded.SourcePaymentMethod)
+
+	// Resources asked for twice are only fetched once
+	expanded, err = mc.GetTransferExpanded(BgCtx(), "account-id", "transfer-id", moov.Expandable_Disputes, moov.Expandable_Refunds, moov.Expandable_Disputes)
+	require.Error(t, err)
+	require.Len(t, expanded.Refunds, 1)
+	require.Len(t, expanded.Disputes, 2)
+
+	_, err = mc.GetTransferExpanded(BgCtx(), "account-id", "transfer-id", moov.Expandable("fees"))
+	require.Error(t, err)
+}
+
+func Test_ListActionableTransfers(t *testing.T) {
+	retriable := moov.FailureReason_Wallet_Insufficient_Funds
+	notRetriable := moov.FailureReason_Rejected_HighRisk
+
+	queries := []string{}
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/accounts/account-id/transfers", r.URL.Path)
+		require.Equal(t, "account-id", r.URL.Query().Get("accountIDs"))
+
+		switch {
+		case r.URL.Query().Get("disputed") == "true":
+			queries = append(queries, "disputed")
+			writeJson(t, w, http.StatusOK, []moov.Transfer{
+				{TransferID: "disputed", Status: moov.TransferStatus_Completed, Disputes: []moov.GetDispute{{DisputeID: "dispute-id"}}},
+				{TransferID: "disputed-failed", Status: moov.TransferStatus_Failed, Disputes: []moov.GetDispute{{DisputeID: "dispute-id"}}},
+			})
+		case r.URL.Query().Get("status") == "failed":
+			queries = append(queries, "failed")
+			writeJson(t, w, http.StatusOK, []moov.Transfer{
+				{TransferID: "disputed-failed", Status: moov.TransferStatus_Failed, Disputes: []moov.GetDispute{{DisputeID: "dispute-id"}}},
+				{TransferID: "retriable", Status: moov.TransferStatus_Failed, FailureReason: &retriable},
+				{TransferID: "not-retriable", Status: moov.TransferStatus_Failed, FailureReason: &notRetriable},
+			})
+		default:
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+
+	transfers, err := mc.ListActionableTransfers(BgCtx(), "account-id")
+	require.NoError(t, err)
+	require.Equal(t, []string{"disputed", "failed"}, queries)
+
+	ids := []string{}
+	for _, t := range transfers {
+		ids = append(ids, t.TransferID)
+	}
+	require.Equal(t, []string{"disputed", "disputed-failed", "retriable"}, ids)
+}
+
+func Test_ListOrderBy(t *testing.T) {
+	var orderBy string
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		orderBy = r.URL.Query().Get("orderBy")
+		writeJson(t, w, http.StatusOK, []any{})
+	}))
+
+	_, err := mc.ListTransfers(BgCtx(), "account-id")
+	require.NoError(t, err)
+	require.Equal(t, "createdOn:desc", orderBy)
+
+	_, err = mc.ListTransfers(BgCtx(), "account-id", moov.WithTransferOrderBy("createdOn", false))
+	require.NoError(t, err)
+	require.Equal(t, "createdOn:asc", orderBy)
+
+	_, err = mc.ListDisputes(BgCtx(), "account-id")
+	require.NoError(t, err)
+	require.Equal(t, "createdOn:desc", orderBy)
+
+	_, err = mc.ListDisputes(BgCtx(), "account-id", moov.WithDisputeOrderByField("respondBy", false))
+	require.NoError(t, err)
+	require.Equal(t, "respondBy:asc", orderBy)
+
+	_, err = mc.ListWalletTransactions(BgCtx(), "account-id", "wallet-id")
+	require.NoError(t, err)
+	require.Equal(t, "createdOn:desc", orderBy)
+
+	_, err = mc.ListWalletTransactions(BgCtx(), "account-id", "wallet-id", moov.WithTransactionOrderBy("completedOn", true))
+	require.NoError(t, err)
+	require.Equal(t, "completedOn:desc", orderBy)
+
+	orderBy = ""
+	_, err = mc.ListTransfers(BgCtx(), "account-id", moov.WithTransferOrderBy("amount", true))
+	require.ErrorContains(t, err, `can't order by "amount"`)
+	require.Empty(t, orderBy)
+
+	_, err = mc.ListDisputes(BgCtx(), "account-id", moov.WithDisputeOrderByField("status", true))
+	require.ErrorContains(t, err, `can't order by "status"`)
+	require.Empty(t, orderBy)
+}
+
+func Test_ReverseTransfer_Amounts(t *testing.T) {
+	var reversed []map[string]any
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/accounts/account-id/transfers/transfer-id":
+			writeJson(t, w, http.StatusOK, moov.Transfer{
+				TransferID:     "transfer-id",
+				Amount:         moov.Amount{Currency: "USD", Value: 10_000},
+				RefundedAmount: &moov.Amount{Currency: "USD", Value: 2_000},
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/accounts/account-id/transfers/transfer-id/reversals":
+			body := map[string]any{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			reversed = append(reversed, body)
+			writeJson(t, w, http.StatusOK, moov.CreatedReversal{Refund: &moov.Refund{RefundID: "refund-id"}})
+		default:
+			t.Errorf("unexpected request to %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	t.Run("full", func(t *testing.T) {
+		_, err := mc.ReverseTransfer(BgCtx(), "account-id", "transfer-id", moov.CreateReversal{}, moov.WithReversalAmountCheck())
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{}, reversed[len(reversed)-1])
+	})
+
+	t.Run("partial", func(t *testing.T) {
+		_, err := mc.ReverseTransfer(BgCtx(), "account-id", "transfer-id", moov.CreateReversal{Amount: 8_000}, moov.WithReversalAmountCheck())
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"amount": float64(8_000)}, reversed[len(reversed)-1])
+	})
+
+	t.Run("over amount", func(t *testing.T) {
+		calls := len(reversed)
+		_, err := mc.ReverseTransfer(BgCtx(), "account-id", "transfer-id", moov.CreateReversal{Amount: 8_001}, moov.WithReversalAmountCheck())
+		require.ErrorIs(t, err, moov.ErrReversalExceedsTransfer)
+		require.Len(t, reversed, calls)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		_, err := mc.ReverseTransfer(BgCtx(), "account-id", "transfer-id", moov.CreateReversal{Amount: -1})
+		require.ErrorIs(t, err, moov.ErrInvalidAmount)
+	})
+}
+
+func Test_PollTransferChanges(t *testing.T) {
+	since := time.Now().AddDate(0, -1, 0)
+	createdOn := time.Now()
+
+	var mu sync.Mutex
+	polls := 0
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/accounts/account-id/transfers" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		mu.Lock()
+		polls++
+		poll := polls
+		mu.Unlock()
+
+		// The first poll starts from since, and later ones only look back a week
+		start, err := time.Parse(time.RFC3339, r.URL.Query().Get("startDateTime"))
+		if err != nil {
+			t.Errorf("parsing startDateTime: %v", err)
+		}
+		switch {
+		case poll == 1 && !start.Equal(since.Truncate(time.Second)):
+			t.Errorf("first poll started from %v", start)
+		case poll > 2 && start.Before(time.Now().AddDate(0, 0, -8)):
+			t.Errorf("poll %d started from %v", poll, start)
+		}
+
+		switch poll {
+		case 1:
+			writeJson(t, w, http.StatusOK, []moov.Transfer{{TransferID: "transfer-1", Status: moov.TransferStatus_Pending, CreatedOn: createdOn}})
+		case 2:
+			// Errors aren't read, which mustn't stop the polling
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			writeJson(t, w, http.StatusOK, []moov.Transfer{
+				{TransferID: "transfer-1", Status: moov.TransferStatus_Completed, CreatedOn: createdOn},
+				{TransferID: "transfer-2", Status: moov.TransferStatus_Pending, CreatedOn: createdOn},
+			})
+		}
+	}))
+
+	ctx, cancel := context.WithCancel(BgCtx())
+	defer cancel()
+
+	changes, errs := mc.PollTransferChanges(ctx, "account-id", since, 10*time.Millisecond)
+
+	received := []string{}
+	for len(received) < 3 {
+		select {
+		case transfer := <-changes:
+			received = append(received, transfer.TransferID+":"+string(transfer.Status))
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for transfer changes")
+		}
+	}
+
+	require.Equal(t, []string{"transfer-1:pending", "transfer-1:completed", "transfer-2:pending"}, received)
+	require.Error(t, <-errs)
+
+	// Unchanged transfers aren't sent again and the channels close once the context ends
+	cancel()
+	for range changes {
+		t.Error("unexpected change after cancel")
+	}
+	for range errs {
+	}
+}
+
+func Test_TransferOptions_Cheapest(t *testing.T) {
+	input := []byte(`{
+		"sourceOptions": [
+			{"paymentMethodID": "card-payment-id", "paymentMethodType": "card-payment", "card": {"cardID": "card-id"}},
+			{"paymentMethodID": "ach-debit-fund-id", "paymentMethodType": "ach-debit-fund", "bankAccount": {"bankAccountID": "bank-account-id"}},
+			{"paymentMethodID": "ach-debit-collect-id", "paymentMethodType": "ach-debit-collect", "bankAccount": {"bankAccountID": "bank-account-id"}}
+		],
+		"destinationOptions": [
+			{"paymentMethodID": "rtp-credit-id", "paymentMethodType": "rtp-credit", "bankAccount": {"bankAccountID": "other-bank-account-id"}},
+			{"paymentMethodID": "ach-credit-same-day-id", "paymentMethodType": "ach-credit-same-day", "bankAccount": {"bankAccountID": "other-bank-account-id"}},
+			{"paymentMethodID": "ach-credit-standard-id", "paymentMethodType": "ach-credit-standard", "bankAccount": {"bankAccountID": "other-bank-account-id"}}
+		]
+	}`)
+
+	options := new(moov.TransferOptions)
+
+	dec := json.NewDecoder(bytes.NewReader(input))
+	dec.DisallowUnknownFields()
+	require.NoError(t, dec.Decode(options))
+
+	t.Run("sources for rail", func(t *testing.T) {
+		ach := options.SourcesFor(moov.RailAch)
+		require.Len(t, ach, 2)
+		require.Equal(t, "ach-debit-fund-id", ach[0].PaymentMethodID)
+		require.Equal(t, "ach-debit-collect-id", ach[1].PaymentMethodID)
+
+		require.Len(t, options.SourcesFor(moov.RailCard), 1)
+		require.Empty(t, options.SourcesFor(moov.RailRtp))
+		require.Len(t, options.DestinationsFor(moov.RailRtp), 1)
+	})
+
+	// Fees in cents of a $100 transfer under an example fee plan, with card payments only paying into wallets
+	fees := map[moov.PaymentMethodType]int64{
+		moov.PaymentMethodType_MoovWallet:        0,
+		moov.PaymentMethodType_AchDebitFund:      15,
+		moov.PaymentMethodType_AchDebitCollect:   15,
+		moov.PaymentMethodType_AchCreditStandard: 15,
+		moov.PaymentMethodType_AchCreditSameDay:  50,
+		moov.PaymentMethodType_RtpCredit:         100,
+		moov.PaymentMethodType_PushToCard:        150,
+		moov.PaymentMethodType_CardPayment:       300,
+	}
+	cost := func(source, destination moov.PaymentMethod) (int64, bool) {
+		if source.PaymentMethodType == moov.PaymentMethodType_CardPayment && destination.PaymentMethodType != moov.PaymentMethodType_MoovWallet {
+			return 0, false
+		}
+		return fees[source.PaymentMethodType] + fees[destination.PaymentMethodType], true
+	}
+
+	t.Run("cheapest pair", func(t *testing.T) {
+		source, destination, ok := options.Cheapest(cost)
+		require.True(t, ok)
+		require.Equal(t, "ach-debit-fund-id", source.PaymentMethodID)
+		require.Equal(t, "ach-credit-standard-id", destination.PaymentMethodID)
+	})
+
+	t.Run("cheapest skips pairs the cost rejects", func(t *testing.T) {
+		opts := moov.TransferOptions{
+			SourceOptions: []moov.PaymentMethod{
+				{PaymentMethodID: "card-payment-id", PaymentMethodType: moov.PaymentMethodType_CardPayment},
+				{PaymentMethodID: "wallet-id", PaymentMethodType: moov.PaymentMethodType_MoovWallet},
+			},
+			DestinationOptions: []moov.PaymentMethod{
+				{PaymentMethodID: "push-to-card-id", PaymentMethodType: moov.PaymentMethodType_PushToCard},
+			},
+		}
+
+		source, destination, ok := opts.Cheapest(cost)
+		require.True(t, ok)
+		require.Equal(t, "wallet-id", source.PaymentMethodID)
+		require.Equal(t, "push-to-card-id", destination.PaymentMethodID)
+	})
+
+	t.Run("no viable pair", func(t *testing.T) {
+		_, _, ok := moov.TransferOptions{
+			SourceOptions:      options.SourcesFor(moov.RailCard),
+			DestinationOptions: options.DestinationOptions,
+		}.Cheapest(cost)
+		require.False(t, ok)
+	})
+}
+
+func Test_ChargeCard(t *testing.T) {
+	declined := moov.FailureReason_Source_Payment_Error
+
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/accounts/merchant-id/payment-methods":
+			require.Equal(t, "moov-wallet", r.URL.Query().Get("paymentMethodType"))
+			writeJson(t, w, http.StatusOK, []moov.PaymentMethod{
+				{PaymentMethodID: "wallet-pm-id", PaymentMethodType: moov.PaymentMethodType_MoovWallet},
+			})
+		case "/accounts/partner-id/transfers":
+			require.Equal(t, http.MethodPost, r.Method)
+			require.Equal(t, "rail-response", r.Header.Get("X-Wait-For"))
+
+			var transfer moov.CreateTransfer
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&transfer))
+			require.Equal(t, "wallet-pm-id", transfer.Destination.PaymentMethodID)
+			require.Equal(t, int64(25), *transfer.FacilitatorFee.Total)
+			require.Equal(t, "WHOLEBODY FITNESS", transfer.Source.CardDetails.DynamicDescriptor)
+
+			result := moov.Transfer{
+				TransferID: "transfer-id",
+				Status:     moov.TransferStatus_Pending,
+				Amount:     transfer.Amount,
+				Source: moov.TransferSource{
+					PaymentMethodID: transfer.Source.PaymentMethodID,
+					CardDetails:     &moov.CardDetails{Status: moov.CardTransactionStatus_Confirmed},
+				},
+			}
+			if transfer.Source.PaymentMethodID == "declined-card-pm-id" {
+				result.Status = moov.TransferStatus_Failed
+				result.FailureReason = &declined
+				result.Source.CardDetails = &moov.CardDetails{Status: moov.CardTransactionStatus_Failed, FailureCode: "card-not-activated"}
+			}
+			writeJson(t, w, http.StatusOK, result)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	fee := int64(25)
+	req := moov.ChargeCardRequest{
+		PartnerAccountID:     "partner-id",
+		DestinationAccountID: "merchant-id",
+		Amount:               moov.Amount{Currency: "USD", Value: 1_000},
+		FacilitatorFee:       moov.CreateTransfer_FacilitatorFee{Total: &fee},
+		DynamicDescriptor:    "WHOLEBODY FITNESS",
+	}
+
+	t.Run("approved", func(t *testing.T) {
+		req := req
+		req.SourcePaymentMethodID = "card-pm-id"
+
+		transfer, err := mc.ChargeCard(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, moov.TransferStatus_Pending, transfer.Status)
+		require.Equal(t, moov.CardTransactionStatus_Confirmed, transfer.Source.CardDetails.Status)
+	})
+
+	t.Run("declined", func(t *testing.T) {
+		req := req
+		req.SourcePaymentMethodID = "declined-card-pm-id"
+
+		transfer, err := mc.ChargeCard(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, moov.TransferStatus_Failed, transfer.Status)
+		require.Equal(t, declined, *transfer.FailureReason)
+		require.Equal(t, "card-not-activated", transfer.Source.CardDetails.FailureCode)
+	})
+
+	t.Run("missing source", func(t *testing.T) {
+		_, err := mc.ChargeCard(context.Background(), req)
+		require.ErrorContains(t, err, "source payment method is required")
+	})
+}
+
+func Test_TransferOptions_SameCurrencyOnly(t *testing.T) {
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/transfer-options", r.URL.Path)
+
+		writeJson(t, w, http.StatusOK, moov.TransferOptions{
+			SourceOptions:      []moov.PaymentMethod{{PaymentMethodID: "wallet-id", PaymentMethodType: moov.PaymentMethodType_MoovWallet}},
+			DestinationOptions: []moov.PaymentMethod{{PaymentMethodID: "other-wallet-id", PaymentMethodType: moov.PaymentMethodType_MoovWallet}},
+		})
+	}))
+
+	payload := moov.CreateTransferOptions{
+		Source:      moov.CreateTransferOptionsTarget{AccountID: "source-account-id"},
+		Destination: moov.CreateTransferOptionsTarget{AccountID: "destination-account-id"},
+		Amount:      moov.Amount{Currency: "usd", Value: 1_000},
+	}
+
+	options, err := mc.TransferOptions(BgCtx(), payload)
+	require.NoError(t, err)
+	require.Len(t, options.SourceOptions, 1)
+
+	payload.Amount.Currency = "EUR"
+	_, err = mc.TransferOptions(BgCtx(), payload)
+	require.ErrorIs(t, err, moov.ErrCurrencyNotSupported)
+}
+
+func Test_TransferOptionsWithCapabilities(t *testing.T) {
+	enabled := func(name moov.CapabilityName) moov.Capability {
+		return moov.Capability{Capability: name, Status: moov.CapabilityStatus_Enabled}
+	}
+
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/transfer-options":
+			writeJson(t, w, http.StatusOK, moov.TransferOptions{
+				SourceOptions: []moov.PaymentMethod{{PaymentMethodID: "source-wallet", PaymentMethodType: moov.PaymentMethodType_MoovWallet}},
+				DestinationOptions: []moov.PaymentMethod{
+					{PaymentMethodID: "destination-wallet", PaymentMethodType: moov.PaymentMethodType_MoovWallet},
+					{PaymentMethodID: "ach-credit", PaymentMethodType: moov.PaymentMethodType_AchCreditStandard},
+				},
+			})
+		case "/accounts/source-account-id/capabilities":
+			writeJson(t, w, http.StatusOK, []moov.Capability{
+				enabled(moov.CapabilityName_Transfers),
+				enabled(moov.CapabilityName_Wallet),
+				{
+					Capability: moov.CapabilityName_SendFunds,
+					Status:     moov.CapabilityStatus_Pending,
+					Requirements: moov.Requirement{
+						CurrentlyDue: []moov.RequirementId{moov.RequirementId_Account_TosAcceptance},
+					},
+				},
+			})
+		case "/accounts/destination-account-id/capabilities":
+			writeJson(t, w, http.StatusOK, []moov.Capability{
+				enabled(moov.CapabilityName_Transfers),
+				enabled(moov.CapabilityName_Wallet),
+			})
+		default:
+			t.Errorf("unexpected request to %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	options, err := mc.TransferOptionsWithCapabilities(BgCtx(), moov.CreateTransferOptions{
+		Source:      moov.CreateTransferOptionsTarget{AccountID: "source-account-id"},
+		Destination: moov.CreateTransferOptionsTarget{AccountID: "destination-account-id"},
+		Amount:      moov.Amount{Currency: "USD", Value: 1_000},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, options.SourceOptions, 1)
+	require.True(t, options.SourceOptions[0].Available)
+	require.Equal(t, "source-wallet", options.SourceOptions[0].PaymentMethodID)
+
+	require.Len(t, options.DestinationOptions, 2)
+	require.True(t, options.DestinationOptions[0].Available)
+	require.Empty(t, options.DestinationOptions[0].BlockingRequirements)
+
+	// Paying out to a bank account waits on send-funds
+	require.False(t, options.DestinationOptions[1].Available)
+	require.Equal(t, []string{"account.tos-acceptance"}, options.DestinationOptions[1].BlockingRequirements)
+}
+
+func Test_PatchTransfer_MetadataMergeAndDelete(t *testing.T) {
+	transfer := moov.Transfer{
+		TransferID: "transfer-id",
+		Metadata:   map[string]string{"order": "1234", "channel": "web"},
+	}
+
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/accounts/account-id/transfers/transfer-id", r.URL.Path)
+
+		switch r.Method {
+		case http.MethodGet:
+			writeJson(t, w, http.StatusOK, transfer)
+		case http.MethodPatch:
+			var patch struct {
+				Metadata map[string]string `json:"metadata"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&patch))
+			require.NotNil(t, patch.Metadata, "metadata should always be sent")
+
+			transfer.Metadata = patch.Metadata
+			writeJson(t, w, http.StatusOK, transfer)
+		default:
+			t.Errorf("unexpected %s request", r.Method)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	t.Run("merge", func(t *testing.T) {
+		patched, err := mc.PatchTransfer(BgCtx(), "account-id", "transfer-id",
+			moov.PatchTransferMetadataMerge(map[string]string{"channel": "mobile", "campaign": "spring"}))
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"order": "1234", "channel": "mobile", "campaign": "spring"}, patched.Metadata)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		patched, err := mc.PatchTransfer(BgCtx(), "account-id", "transfer-id", moov.PatchTransferMetadataDelete("campaign", "missing"))
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"order": "1234", "channel": "mobile"}, patched.Metadata)
+	})
+
+	t.Run("delete every key", func(t *testing.T) {
+		patched, err := mc.PatchTransfer(BgCtx(), "account-id", "transfer-id", moov.PatchTransferMetadataDelete("order", "channel"))
+		require.NoError(t, err)
+		require.Empty(t, patched.Metadata)
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		patched, err := mc.PatchTransfer(BgCtx(), "account-id", "transfer-id", moov.PatchTransferMetadata(map[string]string{"order": "5678"}))
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"order": "5678"}, patched.Metadata)
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		patched, err := mc.PatchTransfer(BgCtx(), "account-id", "transfer-id", moov.PatchTransferMetadataClear())
+		require.NoError(t, err)
+		require.Empty(t, patched.Metadata)
+	})
+}
+
+func Test_PatchTransfer_NilMetadata(t *testing.T) {
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "{}" {
+			t.Errorf("nil metadata should be left out, got %s", body)
+		}
+		writeJson(t, w, http.StatusOK, moov.Transfer{TransferID: "transfer-id"})
+	}))
+
+	_, err := mc.PatchTransfer(BgCtx(), "account-id", "transfer-id", moov.PatchTransferMetadata(nil))
+	require.NoError(t, err)
+}
+
+func Test_Transfer_NetAndTotal(t *testing.T) {
+	decode := func(input string) *moov.Transfer {
+		transfer := new(moov.Transfer)
+
+		dec := json.NewDecoder(strings.NewReader(input))
+		dec.DisallowUnknownFields()
+		require.NoError(t, dec.Decode(transfer))
+
+		return transfer
+	}
+
+	t.Run("with facilitator fee", func(t *testing.T) {
+		transfer := decode(`{
+			"transferID": "ec7e1848-dc80-4ab0-8827-dd7fc0737b43",
+			"status": "completed",
+			"amount": {"currency": "USD", "value": 10000},
+			"facilitatorFee": {"total": 325, "totalDecimal": "325", "markup": 25, "markupDecimal": "25"},
+			"moovFee": 80
+		}`)
+
+		require.Equal(t, moov.Amount{Currency: "USD", Value: 9675}, transfer.NetToDestination())
+		require.Equal(t, moov.Amount{Currency: "USD", Value: 10000}, transfer.TotalFromSource())
+	})
+
+	t.Run("without facilitator fee", func(t *testing.T) {
+		transfer := decode(`{
+			"transferID": "ec7e1848-dc80-4ab0-8827-dd7fc0737b43",
+			"status": "completed",
+			"amount": {"currency": "USD", "value": 10000}
+		}`)
+
+		require.Equal(t, moov.Amount{Currency: "USD", Value: 10000}, transfer.NetToDestination())
+		require.Equal(t, moov.Amount{Currency: "USD", Value: 10000}, transfer.TotalFromSource())
+	})
+}
+
+func Test_CreateTransfer_ActingAccount(t *testing.T) {
+	partnerID := uuid.NewString()
+
+	var acting []string
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/accounts/"+partnerID+"/transfers", r.URL.Path)
+		acting = append(acting, r.Header.Get(moov.ActingAccountHeader))
+
+		writeJson(t, w, http.StatusOK, moov.TransferStarted{TransferID: "transfer-id"})
+	}))
+
+	transfer := moov.CreateTransfer{Amount: moov.Amount{Currency: "USD", Value: 100}}
+
+	_, err := mc.CreateTransfer(BgCtx(), partnerID, transfer).Started()
+	require.NoError(t, err)
+	_, err = mc.CreateTransfer(BgCtx(), partnerID, transfer, moov.WithTransferActingAccount(partnerID)).Started()
+	require.NoError(t, err)
+
+	// Only sent when asked for
+	require.Equal(t, []string{"", partnerID}, acting)
+
+	t.Run("must be a uuid", func(t *testing.T) {
+		_, err := mc.CallHttp(BgCtx(), moov.Endpoint(http.MethodGet, "/accounts/%s/transfers", partnerID), moov.WithActingAccount("partner"))
+		require.ErrorContains(t, err, `acting account "partner" must be a UUID`)
+	})
+}
+
+func Test_SumAmounts(t *testing.T) {
+	refund := func(currency string, value int64) moov.Refund {
+		return moov.Refund{Amount: moov.Amount{Currency: currency, Value: value}}
+	}
+	amount := func(r moov.Refund) moov.Amount { return r.Amount }
+
+	total, err := moov.SumAmounts([]moov.Refund{refund("USD", 1_000), refund("usd", 250), refund("USD", 5)}, amount)
+	require.NoError(t, err)
+	require.Equal(t, moov.Amount{Currency: "USD", Value: 1_255}, total)
+
+	total, err = moov.SumAmounts([]moov.Refund{}, amount)
+	require.NoError(t, err)
+	require.Equal(t, moov.Amount{}, total)
+
+	_, err = moov.SumAmounts([]moov.Refund{refund("USD", 1_000), refund("CAD", 250)}, amount)
+	require.ErrorIs(t, err, moov.ErrCurrencyMismatch)
+}
+
+func Test_Payout(t *testing.T) {
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/accounts/merchant-id/payment-methods":
+			writeJson(t, w, http.StatusOK, []moov.PaymentMethod{
+				{PaymentMethodID: "pull-pm-id", PaymentMethodType: moov.PaymentMethodType_AchDebitFund, BankAccount: &moov.BankAccountPaymentMethod{BankAccountID: "bank-id"}},
+				{PaymentMethodID: "standard-pm-id", PaymentMethodType: moov.PaymentMethodType_AchCreditStandard, BankAccount: &moov.BankAccountPaymentMethod{BankAccountID: "bank-id"}},
+				{PaymentMethodID: "wallet-pm-id", PaymentMethodType: moov.PaymentMethodType_MoovWallet, Wallet: &moov.WalletPaymentMethod{WalletID: "wallet-id"}},
+			})
+		case "/accounts/partner-id/transfers":
+			require.Equal(t, http.MethodPost, r.Method)
+			require.Empty(t, r.Header.Get("X-Wait-For"))
+
+			var transfer moov.CreateTransfer
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&transfer))
+			require.Equal(t, moov.CreateTransfer{
+				Source:      moov.CreateTransfer_Source{PaymentMethodID: "wallet-pm-id"},
+				Destination: moov.CreateTransfer_Destination{PaymentMethodID: "standard-pm-id", AchDetails: &moov.CreateTransfer_AchDetailsBase{CompanyEntryDescription: "PAYOUT"}},
+				Amount:      moov.Amount{Currency: "USD", Value: 5_000},
+				Description: "weekly payout",
+			}, transfer)
+
+			writeJson(t, w, http.StatusOK, moov.TransferStarted{TransferID: "transfer-id"})
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	req := moov.PayoutRequest{
+		PartnerAccountID:        "partner-id",
+		AccountID:               "merchant-id",
+		BankAccountID:           "bank-id",
+		Amount:                  moov.Amount{Currency: "USD", Value: 5_000},
+		CompanyEntryDescription: "PAYOUT",
+		Description:             "weekly payout",
+	}
+
+	t.Run("defaults to standard ach", func(t *testing.T) {
+		completed, started, err := mc.Payout(BgCtx(), req)
+		require.NoError(t, err)
+		require.Nil(t, completed)
+		require.Equal(t, "transfer-id", started.TransferID)
+	})
+
+	t.Run("source must be a wallet", func(t *testing.T) {
+		req := req
+		req.SourcePaymentMethodID = "pull-pm-id"
+
+		_, _, err := mc.Payout(BgCtx(), req)
+		require.ErrorContains(t, err, "not a moov-wallet")
+	})
+
+	t.Run("destination must be a bank account", func(t *testing.T) {
+		req := req
+		req.PaymentMethodType = moov.PaymentMethodType_PushToCard
+
+		_, _, err := mc.Payout(BgCtx(), req)
+		require.ErrorContains(t, err, "payouts can't be made with push-to-card")
+	})
+
+	t.Run("rail not enabled", func(t *testing.T) {
+		req := req
+		req.PaymentMethodType = moov.PaymentMethodType_RtpCredit
+
+		_, _, err := mc.Payout(BgCtx(), req)
+		require.ErrorIs(t, err, moov.ErrPaymentMethodNotEnabled)
+	})
+}
+
+func Test_CreateTransfer_CardLevel3(t *testing.T) {
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.JSONEq(t, `{
+			"source": {
+				"paymentMethodID": "card-pm-id",
+				"cardDetails": {
+					"level2": {"customerReference": "PO-1001", "destinationPostalCode": "80301"},
+					"level3": {
+						"shipToPostalCode": "80301",
+						"freightAmount": {"currency": "USD", "value": 500},
+						"lineItems": [
+							{
+								"description": "Nitrile gloves, box of 100",
+								"productCode": "GLV-100",
+								"quantity": 4,
+								"unitOfMeasure": "box",
+								"unitPrice": {"currency": "USD", "value": 1200},
+								"taxAmount": {"currency": "USD", "value": 384},
+								"total": {"currency": "USD", "value": 5184}
+							}
+						]
+					}
+				}
+			},
+			"destination": {"paymentMethodID": "wallet-pm-id"},
+			"amount": {"currency": "USD", "value": 5684},
+			"salesTaxAmount": {"currency": "USD", "value": 384},
+			"facilitatorFee": {}
+		}`, string(body))
+
+		writeJson(t, w, http.StatusOK, moov.TransferStarted{TransferID: "transfer-id"})
+	}))
+
+	level2 := &moov.CardLevel2{CustomerReference: "PO-1001", DestinationPostalCode: "80301"}
+	level3 := &moov.CardLevel3{
+		ShipToPostalCode: "80301",
+		FreightAmount:    &moov.Amount{Currency: "USD", Value: 500},
+		LineItems: []moov.CardLineItem{{
+			Description:   "Nitrile gloves, box of 100",
+			ProductCode:   "GLV-100",
+			Quantity:      4,
+			UnitOfMeasure: "box",
+			UnitPrice:     moov.Amount{Currency: "USD", Value: 1200},
+			TaxAmount:     &moov.Amount{Currency: "USD", Value: 384},
+			Total:         moov.Amount{Currency: "USD", Value: 5184},
+		}},
+	}
+
+	transfer := moov.CreateTransfer{
+		Source: moov.CreateTransfer_Source{
+			PaymentMethodID: "card-pm-id",
+			CardDetails:     &moov.CreateTransfer_CardDetailsSource{Level2: level2, Level3: level3},
+		},
+		Destination:    moov.CreateTransfer_Destination{PaymentMethodID: "wallet-pm-id"},
+		Amount:         moov.Amount{Currency: "USD", Value: 5684},
+		SalesTaxAmount: &moov.Amount{Currency: "USD", Value: 384},
+	}
+
+	started, err := mc.CreateTransfer(BgCtx(), "account-id", transfer).Started()
+	require.NoError(t, err)
+	require.Equal(t, "transfer-id", started.TransferID)
+
+	t.Run("level 3 requires level 2", func(t *testing.T) {
+		transfer := transfer
+		transfer.Source.CardDetails = &moov.CreateTransfer_CardDetailsSource{Level3: level3}
+
+		_, err := mc.CreateTransfer(BgCtx(), "account-id", transfer).Started()
+		require.ErrorIs(t, err, moov.ErrIncompleteCardLevelData)
+	})
+
+	t.Run("level 2 requires sales tax", func(t *testing.T) {
+		transfer := transfer
+		transfer.SalesTaxAmount = nil
+
+		_, err := mc.CreateTransfer(BgCtx(), "account-id", transfer).Started()
+		require.ErrorIs(t, err, moov.ErrIncompleteCardLevelData)
+	})
+}
+
+func Test_ExportTransfersCSV(t *testing.T) {
+	const total = 450
+
+	// Five transfers are created each second so pages end partway through a second
+	base := time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC)
+	fee := int64(12)
+	var stored []moov.Transfer
+	for i := range total {
+		stored = append(stored, moov.Transfer{
+			TransferID:  fmt.Sprintf("transfer-%d", i),
+			CreatedOn:   base.Add(time.Duration(i/5)*time.Second + time.Duration(i%5)*time.Millisecond),
+			Status:      moov.TransferStatus_Completed,
+			Amount:      moov.Amount{Currency: "USD", Value: 1000},
+			Source:      moov.TransferSource{PaymentMethodID: "source-pm-id"},
+			Destination: moov.TransferDestination{PaymentMethodID: "destination-pm-id"},
+			MoovFee:     &fee,
+		})
+	}
+
+	pages := 0
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		require.Equal(t, "/accounts/account-id/transfers", r.URL.Path)
+		require.Equal(t, "completed", query.Get("status"))
+		require.Equal(t, "createdOn:asc", query.Get("orderBy"))
+		require.NotEmpty(t, query.Get("endDateTime"))
+		pages++
+
+		skip, err := strconv.Atoi(query.Get("skip"))
+		require.NoError(t, err)
+		count, err := strconv.Atoi(query.Get("count"))
+		require.NoError(t, err)
+
+		var start time.Time
+		if v := query.Get("startDateTime"); v != "" {
+			start, err = time.Parse(time.RFC3339, v)
+			require.NoError(t, err)
+		}
+
+		var matched []moov.Transfer
+		for _, transfer := range stored {
+			if !transfer.CreatedOn.Before(start) {
+				matched = append(matched, transfer)
+			}
+		}
+		transfers := matched[min(skip, len(matched)):min(skip+count, len(matched))]
+
+		// A transfer created mid-export, which would shift skip offsets of a newest first listing
+		stored = append([]moov.Transfer{{TransferID: fmt.Sprintf("created-during-%d", pages), CreatedOn: base.Add(-time.Hour)}}, stored...)
+
+		writeJson(t, w, http.StatusOK, transfers)
+	}))
+
+	var buf bytes.Buffer
+	require.NoError(t, mc.ExportTransfersCSV(BgCtx(), &buf, "account-id", moov.WithTransferStatus("completed")))
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, []string{"transferID", "createdOn", "status", "amount", "currency", "source", "destination", "moovFee"}, rows[0])
+	require.Len(t, rows, total+1)
+	require.Equal(t, []string{"transfer-0", "2024-01-10T00:00:00Z", "completed", "1000", "USD", "source-pm-id", "destination-pm-id", "12"}, rows[1])
+	for i, row := range rows[1:] {
+		require.Equal(t, fmt.Sprintf("transfer-%d", i), row[0])
+	}
+	require.Equal(t, 3, pages)
+}
+
+func Test_WithDefaultCurrency(t *testing.T) {
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var transfer moov.CreateTransfer
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&transfer))
+		require.Equal(t, moov.Amount{Currency: "USD", Value: 1_000}, transfer.Amount)
+		require.Equal(t, moov.Amount{Currency: "USD", Value: 80}, *transfer.SalesTaxAmount)
+
+		writeJson(t, w, http.StatusOK, moov.TransferStarted{TransferID: "transfer-id"})
+	}), moov.WithDefaultCurrency("usd"))
+
+	_, err := mc.CreateTransfer(BgCtx(), "account-id", moov.CreateTransfer{
+		Amount:         moov.Amount{Value: 1_000},
+		SalesTaxAmount: &moov.Amount{Value: 80},
+	}).Started()
+	require.NoError(t, err)
+
+	_, err = moov.NewClient(moov.WithCredentials(moov.Credentials{PublicKey: "public-key", SecretKey: "secret-key"}), moov.WithDefaultCurrency("DOLLARS"))
+	require.ErrorIs(t, err, moov.ErrInvalidAmount)
+
+	_, err = moov.NewClient(moov.WithCredentials(moov.Credentials{PublicKey: "public-key", SecretKey: "secret-key"}), moov.WithDefaultCurrency("EUR"))
+	require.ErrorIs(t, err, moov.ErrCurrencyNotSupported)
+}
+
+func Test_FindTransfersByMetadata(t *testing.T) {
+	pages := 0
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/accounts/account-id/transfers", r.URL.Path)
+		require.Equal(t, "200", r.URL.Query().Get("count"))
+		pages++
+
+		// A full first page so the lookup pages to the second
+		var transfers []moov.Transfer
+		if r.URL.Query().Get("skip") == "0" {
+			for i := range 200 {
+				transfers = append(transfers, moov.Transfer{TransferID: fmt.Sprintf("transfer-%d", i), Metadata: map[string]string{"orderID": strconv.Itoa(i)}})
+			}
+			transfers[10].Metadata["orderID"] = "order-1234"
+		} else {
+			transfers = []moov.Transfer{
+				{TransferID: "retry", Metadata: map[string]string{"orderID": "order-1234"}},
+				{TransferID: "no-metadata"},
+			}
+		}
+		writeJson(t, w, http.StatusOK, transfers)
+	}))
+
+	transfers, err := mc.FindTransfersByMetadata(BgCtx(), "account-id", "orderID", "order-1234")
+	require.NoError(t, err)
+	require.Len(t, transfers, 2)
+	require.Equal(t, "transfer-10", transfers[0].TransferID)
+	require.Equal(t, "retry", transfers[1].TransferID)
+	require.Equal(t, 2, pages)
+}
@@ -3,6 +3,7 @@ package moov
 import (
 	"context"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -42,6 +43,15 @@ type WalletTransaction struct {
 	SweepID                 *string                     `json:"sweepID,omitempty"`
 }
 
+// RelatedTransferID returns the ID of the transfer that created the transaction. ok is false for transactions from
+// other sources, such as disputes or issued card activity.
+func (t WalletTransaction) RelatedTransferID() (transferID string, ok bool) {
+	if t.SourceType != WalletTransactionSourceTypeTransfer || t.SourceID == "" {
+		return "", false
+	}
+	return t.SourceID, true
+}
+
 type WalletTransactionStatus string
 
 const (
@@ -113,6 +123,27 @@ func (c Client) GetWallet(ctx context.Context, accountID string, walletID string
 	return CompletedObjectOrError[Wallet](resp)
 }
 
+// GetAccountBalances sums the available balance of every wallet on the account, keyed by currency code.
+func (c Client) GetAccountBalances(ctx context.Context, accountID string) (map[string]Amount, error) {
+	wallets, err := c.ListWallets(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make(map[string]Amount)
+	for _, wallet := range wallets {
+		currency := strings.ToUpper(wallet.AvailableBalance.Currency)
+
+		balance := balances[currency]
+		balance.Currency = currency
+		balance.Value += wallet.AvailableBalance.Value
+
+		balances[currency] = balance
+	}
+
+	return balances, nil
+}
+
 type ListTransactionFilter callArg
 
 // WithTransactionType filters transactions by transaction type
@@ -131,7 +162,8 @@ func WithTransactionTypes(transactionTypes string) ListTransactionFilter {
 	})
 }
 
-// WithSourceType filters transactions by source type (transfer, dispute, issuing-transaction).
+// WithTransactionSourceType filters transactions by the type of resource that created them, one of the
+// WalletTransactionSourceType values such as transfer, dispute, or issuing-card-transaction.
 func WithTransactionSourceType(sourceType string) ListTransactionFilter {
 	return callBuilderFn(func(call *callBuilder) error {
 		call.params["sourceType"] = sourceType
@@ -139,7 +171,7 @@ func WithTransactionSourceType(sourceType string) ListTransactionFilter {
 	})
 }
 
-// WithSourceID filters transactions by source ID
+// WithTransactionSourceID filters transactions by the ID of the resource that created them, such as a transfer ID
 func WithTransactionSourceID(sourceID string) ListTransactionFilter {
 	return callBuilderFn(func(call *callBuilder) error {
 		call.params["sourceID"] = sourceID
@@ -197,6 +229,15 @@ func WithCompletedEndDateTime(completedEndDateTime time.Time) ListTransactionFil
 	})
 }
 
+// Fields wallet transactions can be ordered by
+var transactionOrderByFields = []string{"createdOn", "completedOn"}
+
+// WithTransactionOrderBy sorts the transactions by createdOn or completedOn. Transactions are ordered by createdOn
+// descending by default so paging through them is stable.
+func WithTransactionOrderBy(field string, desc bool) ListTransactionFilter {
+	return orderBy(field, desc, transactionOrderByFields...)
+}
+
 // WithSweepID filters for transactions accrued in a sweep
 func WithSweepID(sweepID string) ListTransactionFilter {
 	return callBuilderFn(func(call *callBuilder) error {
@@ -208,7 +249,7 @@ func WithSweepID(sweepID string) ListTransactionFilter {
 // ListWalletTransactions lists all transactions for the given wallet id
 // https://docs.moov.io/api/index.html#tag/Wallet-transactions
 func (c Client) ListWalletTransactions(ctx context.Context, accountID string, walletID string, opts ...ListTransactionFilter) ([]WalletTransaction, error) {
-	args := prependArgs(opts, AcceptJson())
+	args := prependArgs(opts, AcceptJson(), WithTransactionOrderBy("createdOn", true))
 	resp, err := c.CallHttp(ctx, Endpoint(http.MethodGet, pathWalletTransactions, accountID, walletID), args...)
 	if err != nil {
 		return nil, err
@@ -217,6 +258,22 @@ func (c Client) ListWalletTransactions(ctx context.Context, accountID string, wa
 	return CompletedListOrError[WalletTransaction](resp)
 }
 
+// WalletEntriesForTransfer lists the wallet's transactions created by the transfer, oldest first, such as the debit,
+// fee, and credit it moved through the wallet.
+func (c Client) WalletEntriesForTransfer(ctx context.Context, accountID, walletID, transferID string) ([]WalletTransaction, error) {
+	return listAllPages(func(skip, count int) ([]WalletTransaction, error) {
+		return c.ListWalletTransactions(ctx, accountID, walletID,
+			WithTransactionSourceType(string(WalletTransactionSourceTypeTransfer)),
+			WithTransactionSourceID(transferID),
+			WithTransactionOrderBy("createdOn", false),
+			WithTransactionCount(count),
+			WithTransactionSkip(skip))
+	}, func(transaction WalletTransaction) bool {
+		id, ok := transaction.RelatedTransferID()
+		return ok && id == transferID
+	})
+}
+
 // GetWalletTransaction retrieves a transaction for the given wallet id and transaction id
 // https://docs.moov.io/api/index.html#tag/Wallet-transactions/operation/getWalletTransaction
 func (c Client) GetWalletTransaction(ctx context.Context, accountID string, walletID string, transactionID string) (*WalletTransaction, error) {
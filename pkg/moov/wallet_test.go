@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"net/http"
 	"testing"
 
 	"github.com/moovfinancial/moov-go/pkg/moov"
@@ -78,3 +79,95 @@ func TestListWalletTransactions(t *testing.T) {
 		require.Equal(t, transactions[i].TransactionID, txn.TransactionID)
 	}
 }
+
+func TestGetAccountBalances(t *testing.T) {
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/accounts/account-id/wallets", r.URL.Path)
+
+		writeJson(t, w, http.StatusOK, []moov.Wallet{
+			{WalletID: "usd-1", AvailableBalance: moov.AvailableBalance{Currency: "USD", Value: 1204, ValueDecimal: "12.04"}},
+			{WalletID: "cad", AvailableBalance: moov.AvailableBalance{Currency: "CAD", Value: 500, ValueDecimal: "5.00"}},
+			{WalletID: "usd-2", AvailableBalance: moov.AvailableBalance{Currency: "usd", Value: 96, ValueDecimal: "0.96"}},
+		})
+	}))
+
+	balances, err := mc.GetAccountBalances(context.Background(), "account-id")
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]moov.Amount{
+		"USD": {Currency: "USD", Value: 1300},
+		"CAD": {Currency: "CAD", Value: 500},
+	}, balances)
+}
+
+func TestWalletTransaction_RelatedTransferID(t *testing.T) {
+	input := []byte(`[
+		{
+			"walletID": "ec7e1848-dc80-4ab0-8827-dd7fc0737b43",
+			"transactionID": "b1a3d3a4-0b6c-4c4d-9d4e-2a1d3c4b5e6f",
+			"transactionType": "card-payment",
+			"sourceType": "transfer",
+			"sourceID": "c4b3a2d1-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+			"status": "completed",
+			"memo": "card payment",
+			"createdOn": "2024-05-01T14:30:00Z",
+			"completedOn": "2024-05-01T14:30:02Z",
+			"currency": "USD",
+			"grossAmount": 1000,
+			"grossAmountDecimal": "1000",
+			"fee": 0,
+			"feeDecimal": "0",
+			"netAmount": 1000,
+			"netAmountDecimal": "1000",
+			"availableBalance": 5000,
+			"availableBalanceDecimal": "5000"
+		},
+		{
+			"walletID": "ec7e1848-dc80-4ab0-8827-dd7fc0737b43",
+			"transactionID": "d5e6f7a8-1b2c-4d3e-8f4a-5b6c7d8e9f0a",
+			"transactionType": "dispute",
+			"sourceType": "dispute",
+			"sourceID": "f0e1d2c3-b4a5-4968-8776-655443322110",
+			"status": "completed",
+			"createdOn": "2024-05-03T09:00:00Z",
+			"currency": "USD",
+			"grossAmount": -1000,
+			"netAmount": -1000,
+			"availableBalance": 4000
+		}
+	]`)
+
+	var transactions []moov.WalletTransaction
+
+	dec := json.NewDecoder(bytes.NewReader(input))
+	dec.DisallowUnknownFields()
+	require.NoError(t, dec.Decode(&transactions))
+
+	transferID, ok := transactions[0].RelatedTransferID()
+	require.True(t, ok)
+	require.Equal(t, "c4b3a2d1-5e6f-4a7b-8c9d-0e1f2a3b4c5d", transferID)
+
+	_, ok = transactions[1].RelatedTransferID()
+	require.False(t, ok)
+}
+
+func TestWalletEntriesForTransfer(t *testing.T) {
+	mc := NewMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/accounts/account-id/wallets/wallet-id/transactions", r.URL.Path)
+		require.Equal(t, "transfer", r.URL.Query().Get("sourceType"))
+		require.Equal(t, "transfer-id", r.URL.Query().Get("sourceID"))
+		require.Equal(t, "createdOn:asc", r.URL.Query().Get("orderBy"))
+
+		writeJson(t, w, http.StatusOK, []moov.WalletTransaction{
+			{TransactionID: "payment", TransactionType: moov.WalletTransactionTypeCardPayment, SourceType: moov.WalletTransactionSourceTypeTransfer, SourceID: "transfer-id", GrossAmount: -1000},
+			{TransactionID: "fee", TransactionType: moov.WalletTransactionTypeFacilitatorFee, SourceType: moov.WalletTransactionSourceTypeTransfer, SourceID: "transfer-id", Fee: 25},
+			{TransactionID: "other-transfer", SourceType: moov.WalletTransactionSourceTypeTransfer, SourceID: "other-transfer-id"},
+		})
+	}))
+
+	entries, err := mc.WalletEntriesForTransfer(BgCtx(), "account-id", "wallet-id", "transfer-id")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "payment", entries[0].TransactionID)
+	require.Equal(t, "fee", entries[1].TransactionID)
+}